@@ -198,6 +198,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutequipment"
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
+	"github.com/aws/aws-sdk-go/service/m2"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
 	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
@@ -519,6 +520,7 @@ type AWSClient struct {
 	lookoutequipmentConn             *lookoutequipment.LookoutEquipment
 	lookoutmetricsConn               *lookoutmetrics.LookoutMetrics
 	lookoutvisionConn                *lookoutforvision.LookoutForVision
+	m2Conn                           *m2.M2
 	mqConn                           *mq.MQ
 	mturkConn                        *mturk.MTurk
 	mwaaConn                         *mwaa.MWAA
@@ -1367,6 +1369,10 @@ func (client *AWSClient) LookoutVisionConn() *lookoutforvision.LookoutForVision
 	return client.lookoutvisionConn
 }
 
+func (client *AWSClient) M2Conn() *m2.M2 {
+	return client.m2Conn
+}
+
 func (client *AWSClient) MQConn() *mq.MQ {
 	return client.mqConn
 }
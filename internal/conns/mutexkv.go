@@ -1,6 +1,7 @@
 package conns
 
 import (
+	"context"
 	"sync"
 )
 
@@ -21,6 +22,33 @@ func (m *mutexKV) Lock(key string) {
 	m.get(key).Lock()
 }
 
+// LockWithContext locks the mutex for the given key, giving up if the context is
+// done before the lock is acquired. The context's error is returned in that case
+// and the caller must not call Unlock. Otherwise the caller is responsible for
+// calling Unlock for the same key
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	mutex := m.get(key)
+	lockedCh := make(chan struct{})
+
+	go func() {
+		mutex.Lock()
+		close(lockedCh)
+	}()
+
+	select {
+	case <-lockedCh:
+		return nil
+	case <-ctx.Done():
+		// Release the lock as soon as the abandoned attempt acquires it.
+		go func() {
+			<-lockedCh
+			mutex.Unlock()
+		}()
+
+		return ctx.Err()
+	}
+}
+
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	m.get(key).Unlock()
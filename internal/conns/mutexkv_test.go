@@ -1,6 +1,8 @@
 package conns
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 )
@@ -71,3 +73,36 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockWithContext(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	if err := mkv.LockWithContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error taking lock: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockWithContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded taking held lock, got: %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Lock blocked after timed out lock attempt. This shouldn't happen.")
+	}
+}
@@ -126,6 +126,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
@@ -151,6 +152,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
@@ -645,6 +647,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_elasticsearch_domain": elasticsearch.DataSourceDomain(),
 
+			"aws_elastictranscoder_pipeline": elastictranscoder.DataSourcePipeline(),
+
 			"aws_elb":                 elb.DataSourceLoadBalancer(),
 			"aws_elb_hosted_zone_id":  elb.DataSourceHostedZoneID(),
 			"aws_elb_service_account": elb.DataSourceServiceAccount(),
@@ -663,6 +667,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_emrcontainers_virtual_cluster": emrcontainers.DataSourceVirtualCluster(),
 
+			"aws_evidently_feature": evidently.DataSourceFeature(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.DataSourceDeliveryStream(),
 
 			"aws_fsx_openzfs_snapshot": fsx.DataSourceOpenzfsSnapshot(),
@@ -880,11 +886,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_serverlessapplicationrepository_application": serverlessrepo.DataSourceApplication(),
 
-			"aws_servicecatalog_constraint":            servicecatalog.DataSourceConstraint(),
-			"aws_servicecatalog_launch_paths":          servicecatalog.DataSourceLaunchPaths(),
-			"aws_servicecatalog_portfolio_constraints": servicecatalog.DataSourcePortfolioConstraints(),
-			"aws_servicecatalog_portfolio":             servicecatalog.DataSourcePortfolio(),
-			"aws_servicecatalog_product":               servicecatalog.DataSourceProduct(),
+			"aws_servicecatalog_constraint":               servicecatalog.DataSourceConstraint(),
+			"aws_servicecatalog_launch_paths":             servicecatalog.DataSourceLaunchPaths(),
+			"aws_servicecatalog_portfolio_constraints":    servicecatalog.DataSourcePortfolioConstraints(),
+			"aws_servicecatalog_portfolio":                servicecatalog.DataSourcePortfolio(),
+			"aws_servicecatalog_product":                  servicecatalog.DataSourceProduct(),
+			"aws_servicecatalog_provisioning_artifact_id": servicecatalog.DataSourceProvisioningArtifactID(),
+			"aws_servicecatalog_provisioning_artifacts":   servicecatalog.DataSourceProvisioningArtifacts(),
+			"aws_servicecatalog_provisioning_parameters":  servicecatalog.DataSourceProvisioningParameters(),
 
 			"aws_service_discovery_dns_namespace":  servicediscovery.DataSourceDNSNamespace(),
 			"aws_service_discovery_http_namespace": servicediscovery.DataSourceHTTPNamespace(),
@@ -904,12 +913,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_sqs_queue":  sqs.DataSourceQueue(),
 			"aws_sqs_queues": sqs.DataSourceQueues(),
 
-			"aws_ssm_document":            ssm.DataSourceDocument(),
-			"aws_ssm_instances":           ssm.DataSourceInstances(),
-			"aws_ssm_maintenance_windows": ssm.DataSourceMaintenanceWindows(),
-			"aws_ssm_parameter":           ssm.DataSourceParameter(),
-			"aws_ssm_parameters_by_path":  ssm.DataSourceParametersByPath(),
-			"aws_ssm_patch_baseline":      ssm.DataSourcePatchBaseline(),
+			"aws_ssm_document":                         ssm.DataSourceDocument(),
+			"aws_ssm_instances":                        ssm.DataSourceInstances(),
+			"aws_ssm_maintenance_windows":              ssm.DataSourceMaintenanceWindows(),
+			"aws_ssm_parameter":                        ssm.DataSourceParameter(),
+			"aws_ssm_parameters_by_path":               ssm.DataSourceParametersByPath(),
+			"aws_ssm_patch_baseline":                   ssm.DataSourcePatchBaseline(),
+			"aws_ssm_patch_baseline_effective_patches": ssm.DataSourcePatchBaselineEffectivePatches(),
 
 			"aws_ssoadmin_instances":      ssoadmin.DataSourceInstances(),
 			"aws_ssoadmin_permission_set": ssoadmin.DataSourcePermissionSet(),
@@ -1377,6 +1387,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
 			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_announcement":     ec2.ResourceTransitGatewayRouteTableAnnouncement(),
 			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
 			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
 			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
@@ -1764,6 +1775,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_location_tracker":             location.ResourceTracker(),
 			"aws_location_tracker_association": location.ResourceTrackerAssociation(),
 
+			"aws_m2_application": m2.ResourceApplication(),
+
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
 
@@ -1940,6 +1953,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
@@ -1951,6 +1966,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_records_exclusive":             route53.ResourceRecordsExclusive(),
 			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
 			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
@@ -2008,31 +2024,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
-			"aws_sagemaker_app":                                       sagemaker.ResourceApp(),
-			"aws_sagemaker_app_image_config":                          sagemaker.ResourceAppImageConfig(),
-			"aws_sagemaker_code_repository":                           sagemaker.ResourceCodeRepository(),
-			"aws_sagemaker_device":                                    sagemaker.ResourceDevice(),
-			"aws_sagemaker_device_fleet":                              sagemaker.ResourceDeviceFleet(),
-			"aws_sagemaker_domain":                                    sagemaker.ResourceDomain(),
-			"aws_sagemaker_endpoint":                                  sagemaker.ResourceEndpoint(),
-			"aws_sagemaker_endpoint_configuration":                    sagemaker.ResourceEndpointConfiguration(),
-			"aws_sagemaker_feature_group":                             sagemaker.ResourceFeatureGroup(),
-			"aws_sagemaker_flow_definition":                           sagemaker.ResourceFlowDefinition(),
-			"aws_sagemaker_human_task_ui":                             sagemaker.ResourceHumanTaskUI(),
-			"aws_sagemaker_image":                                     sagemaker.ResourceImage(),
-			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(),
-			"aws_sagemaker_model":                                     sagemaker.ResourceModel(),
-			"aws_sagemaker_model_package_group":                       sagemaker.ResourceModelPackageGroup(),
-			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
-			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
-			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
-			"aws_sagemaker_project":                                   sagemaker.ResourceProject(),
-			"aws_sagemaker_space":                                     sagemaker.ResourceSpace(),
-			"aws_sagemaker_servicecatalog_portfolio_status":           sagemaker.ResourceServicecatalogPortfolioStatus(),
-			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
-			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
-			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
-			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
+			"aws_sagemaker_app":                                        sagemaker.ResourceApp(),
+			"aws_sagemaker_app_image_config":                           sagemaker.ResourceAppImageConfig(),
+			"aws_sagemaker_code_repository":                            sagemaker.ResourceCodeRepository(),
+			"aws_sagemaker_device":                                     sagemaker.ResourceDevice(),
+			"aws_sagemaker_device_fleet":                               sagemaker.ResourceDeviceFleet(),
+			"aws_sagemaker_domain":                                     sagemaker.ResourceDomain(),
+			"aws_sagemaker_endpoint":                                   sagemaker.ResourceEndpoint(),
+			"aws_sagemaker_endpoint_configuration":                     sagemaker.ResourceEndpointConfiguration(),
+			"aws_sagemaker_feature_group":                              sagemaker.ResourceFeatureGroup(),
+			"aws_sagemaker_flow_definition":                            sagemaker.ResourceFlowDefinition(),
+			"aws_sagemaker_human_task_ui":                              sagemaker.ResourceHumanTaskUI(),
+			"aws_sagemaker_image":                                      sagemaker.ResourceImage(),
+			"aws_sagemaker_image_version":                              sagemaker.ResourceImageVersion(),
+			"aws_sagemaker_model":                                      sagemaker.ResourceModel(),
+			"aws_sagemaker_model_package_group":                        sagemaker.ResourceModelPackageGroup(),
+			"aws_sagemaker_model_package_group_policy":                 sagemaker.ResourceModelPackageGroupPolicy(),
+			"aws_sagemaker_notebook_instance":                          sagemaker.ResourceNotebookInstance(),
+			"aws_sagemaker_notebook_instance_lifecycle_configuration":  sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
+			"aws_sagemaker_project":                                    sagemaker.ResourceProject(),
+			"aws_sagemaker_space":                                      sagemaker.ResourceSpace(),
+			"aws_sagemaker_servicecatalog_portfolio_status":            sagemaker.ResourceServicecatalogPortfolioStatus(),
+			"aws_sagemaker_studio_lifecycle_config":                    sagemaker.ResourceStudioLifecycleConfig(),
+			"aws_sagemaker_studio_lifecycle_config_domain_association": sagemaker.ResourceStudioLifecycleConfigDomainAssociation(),
+			"aws_sagemaker_user_profile":                               sagemaker.ResourceUserProfile(),
+			"aws_sagemaker_workforce":                                  sagemaker.ResourceWorkforce(),
+			"aws_sagemaker_workteam":                                   sagemaker.ResourceWorkteam(),
 
 			"aws_scheduler_schedule_group": scheduler.ResourceScheduleGroup(),
 
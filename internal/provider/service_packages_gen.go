@@ -120,6 +120,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
@@ -148,6 +149,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
@@ -312,6 +314,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		lightsail.ServicePackage,
 		location.ServicePackage,
 		logs.ServicePackage,
+		m2.ServicePackage,
 		macie.ServicePackage,
 		macie2.ServicePackage,
 		mediaconnect.ServicePackage,
@@ -340,6 +343,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		resiliencehub.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
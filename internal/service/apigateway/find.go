@@ -10,6 +10,34 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func FindIntegrationResponse(ctx context.Context, conn *apigateway.APIGateway, restAPIID, resourceID, httpMethod, statusCode string) (*apigateway.IntegrationResponse, error) {
+	input := &apigateway.GetIntegrationResponseInput{
+		HttpMethod: aws.String(httpMethod),
+		ResourceId: aws.String(resourceID),
+		RestApiId:  aws.String(restAPIID),
+		StatusCode: aws.String(statusCode),
+	}
+
+	output, err := conn.GetIntegrationResponseWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindStageByName(ctx context.Context, conn *apigateway.APIGateway, restApiId, name string) (*apigateway.Stage, error) {
 	input := &apigateway.GetStageInput{
 		RestApiId: aws.String(restApiId),
@@ -2,6 +2,7 @@ package apigateway
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -11,11 +12,13 @@ import (
 )
 
 func expandMethodParametersOperations(d *schema.ResourceData, key string, prefix string) []*apigateway.PatchOperation {
-	operations := make([]*apigateway.PatchOperation, 0)
-
 	oldParameters, newParameters := d.GetChange(key)
-	oldParametersMap := oldParameters.(map[string]interface{})
-	newParametersMap := newParameters.(map[string]interface{})
+
+	return expandMethodParametersMapOperations(oldParameters.(map[string]interface{}), newParameters.(map[string]interface{}), prefix)
+}
+
+func expandMethodParametersMapOperations(oldParametersMap, newParametersMap map[string]interface{}, prefix string) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
 
 	for k := range oldParametersMap {
 		operation := apigateway.PatchOperation{
@@ -64,11 +67,13 @@ func expandMethodParametersOperations(d *schema.ResourceData, key string, prefix
 }
 
 func expandRequestResponseModelOperations(d *schema.ResourceData, key string, prefix string) []*apigateway.PatchOperation {
-	operations := make([]*apigateway.PatchOperation, 0)
-
 	oldModels, newModels := d.GetChange(key)
-	oldModelMap := oldModels.(map[string]interface{})
-	newModelMap := newModels.(map[string]interface{})
+
+	return expandRequestResponseModelMapOperations(oldModels.(map[string]interface{}), newModels.(map[string]interface{}), prefix)
+}
+
+func expandRequestResponseModelMapOperations(oldModelMap, newModelMap map[string]interface{}, prefix string) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
 
 	for k := range oldModelMap {
 		operation := apigateway.PatchOperation{
@@ -106,6 +111,28 @@ func expandRequestResponseModelOperations(d *schema.ResourceData, key string, pr
 	return operations
 }
 
+// patchOperationOrder is the position of each patch operation in a sorted batch.
+// Removes come first so that a replacement added to the same batch doesn't conflict with the value it replaces.
+var patchOperationOrder = map[string]int{
+	apigateway.OpRemove:  0,
+	apigateway.OpReplace: 1,
+	apigateway.OpAdd:     2,
+}
+
+// sortPatchOperations sorts patch operations by operation, removes first and then replaces and adds, and then by path,
+// so that the batch is applied in the same order regardless of map iteration order.
+func sortPatchOperations(operations []*apigateway.PatchOperation) {
+	sort.SliceStable(operations, func(i, j int) bool {
+		oi, oj := patchOperationOrder[aws.StringValue(operations[i].Op)], patchOperationOrder[aws.StringValue(operations[j].Op)]
+
+		if oi != oj {
+			return oi < oj
+		}
+
+		return aws.StringValue(operations[i].Path) < aws.StringValue(operations[j].Path)
+	})
+}
+
 func FlattenThrottleSettings(settings *apigateway.ThrottleSettings) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 
@@ -1,6 +1,7 @@
 package apigateway
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -47,3 +48,273 @@ func TestFlattenThrottleSettings(t *testing.T) {
 		t.Fatalf("Expected 'rate_limit' to equal %f, got %f", expectedRateLimit, rateLimitFloat)
 	}
 }
+
+func TestSortPatchOperations(t *testing.T) {
+	t.Parallel()
+
+	operations := []*apigateway.PatchOperation{
+		{Op: aws.String(apigateway.OpAdd), Path: aws.String("/responseParameters/method.response.header.X-New")},
+		{Op: aws.String(apigateway.OpReplace), Path: aws.String("/responseModels/application~1json")},
+		{Op: aws.String(apigateway.OpRemove), Path: aws.String("/responseParameters/method.response.header.X-Old")},
+		{Op: aws.String(apigateway.OpAdd), Path: aws.String("/responseModels/text~1xml")},
+		{Op: aws.String(apigateway.OpRemove), Path: aws.String("/responseModels/application~1xml")},
+	}
+
+	sortPatchOperations(operations)
+
+	expected := []string{
+		"remove /responseModels/application~1xml",
+		"remove /responseParameters/method.response.header.X-Old",
+		"replace /responseModels/application~1json",
+		"add /responseModels/text~1xml",
+		"add /responseParameters/method.response.header.X-New",
+	}
+
+	for i, operation := range operations {
+		if got := aws.StringValue(operation.Op) + " " + aws.StringValue(operation.Path); got != expected[i] {
+			t.Errorf("operation %d = %q, expected %q", i, got, expected[i])
+		}
+	}
+}
+
+func TestMethodResponsesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	base := &apigateway.MethodResponse{
+		ResponseModels:     aws.StringMap(map[string]string{"application/json": "Empty"}),
+		ResponseParameters: aws.BoolMap(map[string]bool{"method.response.header.Content-Type": true}),
+		StatusCode:         aws.String("200"),
+	}
+
+	testCases := []struct {
+		Name     string
+		Other    *apigateway.MethodResponse
+		Expected bool
+	}{
+		{
+			Name: "same models and parameters",
+			Other: &apigateway.MethodResponse{
+				ResponseModels:     aws.StringMap(map[string]string{"application/json": "Empty"}),
+				ResponseParameters: aws.BoolMap(map[string]bool{"method.response.header.Content-Type": true}),
+				StatusCode:         aws.String("400"),
+			},
+			Expected: true,
+		},
+		{
+			Name: "different model",
+			Other: &apigateway.MethodResponse{
+				ResponseModels:     aws.StringMap(map[string]string{"application/json": "Error"}),
+				ResponseParameters: aws.BoolMap(map[string]bool{"method.response.header.Content-Type": true}),
+				StatusCode:         aws.String("400"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "missing parameter",
+			Other: &apigateway.MethodResponse{
+				ResponseModels: aws.StringMap(map[string]string{"application/json": "Empty"}),
+				StatusCode:     aws.String("400"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "different parameter value",
+			Other: &apigateway.MethodResponse{
+				ResponseModels:     aws.StringMap(map[string]string{"application/json": "Empty"}),
+				ResponseParameters: aws.BoolMap(map[string]bool{"method.response.header.Content-Type": false}),
+				StatusCode:         aws.String("400"),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := methodResponsesEquivalent(base, testCase.Other); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestUnmappedMethodResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                          string
+		MethodResponseParameters      map[string]bool
+		IntegrationResponseParameters map[string]string
+		Expected                      []string
+	}{
+		{
+			Name: "no parameters",
+		},
+		{
+			Name: "all mapped",
+			MethodResponseParameters: map[string]bool{
+				"method.response.header.Content-Type": true,
+				"method.response.header.X-Request-Id": false,
+			},
+			IntegrationResponseParameters: map[string]string{
+				"method.response.header.Content-Type": "integration.response.header.Content-Type",
+				"method.response.header.X-Request-Id": "context.requestId",
+			},
+		},
+		{
+			Name: "unmapped headers sorted",
+			MethodResponseParameters: map[string]bool{
+				"method.response.header.X-Request-Id":  true,
+				"method.response.header.Content-Type":  true,
+				"method.response.header.Cache-Control": false,
+			},
+			IntegrationResponseParameters: map[string]string{
+				"method.response.header.Content-Type": "'application/json'",
+			},
+			Expected: []string{"Cache-Control", "X-Request-Id"},
+		},
+		{
+			Name: "no integration response parameters",
+			MethodResponseParameters: map[string]bool{
+				"method.response.header.Content-Type": true,
+			},
+			Expected: []string{"Content-Type"},
+		},
+		{
+			Name: "non-header parameters ignored",
+			MethodResponseParameters: map[string]bool{
+				"method.response.querystring.page": true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := unmappedMethodResponseHeaders(testCase.MethodResponseParameters, testCase.IntegrationResponseParameters)
+
+			if len(got) != 0 || len(testCase.Expected) != 0 {
+				if !reflect.DeepEqual(got, testCase.Expected) {
+					t.Errorf("got %q, expected %q", got, testCase.Expected)
+				}
+			}
+		})
+	}
+}
+
+func TestMethodResponseCORSPreflightParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Parameters    map[string]interface{}
+		CORSPreflight []interface{}
+		Expected      map[string]interface{}
+	}{
+		{
+			Name:     "no cors_preflight",
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "defaults",
+			CORSPreflight: []interface{}{map[string]interface{}{
+				"allow_credentials": false,
+				"allow_headers":     true,
+				"allow_methods":     true,
+				"allow_origin":      true,
+			}},
+			Expected: map[string]interface{}{
+				"method.response.header.Access-Control-Allow-Headers": false,
+				"method.response.header.Access-Control-Allow-Methods": false,
+				"method.response.header.Access-Control-Allow-Origin":  false,
+			},
+		},
+		{
+			Name: "explicitly configured headers left out",
+			Parameters: map[string]interface{}{
+				"method.response.header.Access-Control-Allow-Origin": true,
+				"method.response.header.Content-Type":                true,
+			},
+			CORSPreflight: []interface{}{map[string]interface{}{
+				"allow_credentials": true,
+				"allow_headers":     false,
+				"allow_methods":     false,
+				"allow_origin":      true,
+			}},
+			Expected: map[string]interface{}{
+				"method.response.header.Access-Control-Allow-Credentials": false,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := methodResponseCORSPreflightParameters(testCase.Parameters, testCase.CORSPreflight)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestMethodResponseRegisteredAliasModels(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		Models          map[string]interface{}
+		RegisterAliases bool
+		Expected        map[string]interface{}
+	}{
+		{
+			Name: "register_aliases disabled",
+			Models: map[string]interface{}{
+				"application/json": "Empty",
+			},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "aliases registered",
+			Models: map[string]interface{}{
+				"application/json": "Empty",
+				"application/xml":  "Error",
+				"text/plain":       "Empty",
+			},
+			RegisterAliases: true,
+			Expected: map[string]interface{}{
+				"text/json": "Empty",
+				"text/xml":  "Error",
+			},
+		},
+		{
+			Name: "explicitly configured aliases left out",
+			Models: map[string]interface{}{
+				"application/json": "Empty",
+				"text/json":        "Error",
+			},
+			RegisterAliases: true,
+			Expected:        map[string]interface{}{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := methodResponseRegisteredAliasModels(testCase.Models, testCase.RegisterAliases)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
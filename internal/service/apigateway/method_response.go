@@ -2,11 +2,13 @@ package apigateway
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,17 +18,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-var resourceMethodResponseMutex = &sync.Mutex{}
-
 func ResourceMethodResponse() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMethodResponseCreate,
 		ReadWithoutTimeout:   resourceMethodResponseRead,
 		UpdateWithoutTimeout: resourceMethodResponseUpdate,
 		DeleteWithoutTimeout: resourceMethodResponseDelete,
+		CustomizeDiff:        resourceMethodResponseCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
@@ -69,65 +77,173 @@ func ResourceMethodResponse() *schema.Resource {
 			"status_code": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 
-			"response_models": {
-				Type:     schema.TypeMap,
+			"additional_status_codes": {
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"response_models": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validMethodResponseModels,
+			},
+
 			"response_parameters": {
 				Type:     schema.TypeMap,
 				Elem:     &schema.Schema{Type: schema.TypeBool},
 				Optional: true,
 			},
+
+			"cors_preflight": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"allow_headers": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"allow_methods": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"allow_origin": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"cors_preflight_response_parameters": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+				Computed: true,
+			},
+
+			"register_aliases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"registered_alias_response_models": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"response_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"validate_against_integration_response": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func resourceMethodResponseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).APIGatewayConn()
+func resourceMethodResponseCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The headers added by cors_preflight are tracked separately from response_parameters, so that a header
+	// that is removed outside of Terraform shows up as a change to cors_preflight_response_parameters.
+	// Likewise, the models registered for media type aliases are tracked in registered_alias_response_models.
+	if d.NewValueKnown("cors_preflight") && d.NewValueKnown("response_parameters") {
+		corsPreflightParameters := methodResponseCORSPreflightParameters(d.Get("response_parameters").(map[string]interface{}), d.Get("cors_preflight").([]interface{}))
 
-	models := make(map[string]string)
-	for k, v := range d.Get("response_models").(map[string]interface{}) {
-		models[k] = v.(string)
+		if o := d.Get("cors_preflight_response_parameters").(map[string]interface{}); len(o) != len(corsPreflightParameters) || (len(o) > 0 && !reflect.DeepEqual(o, corsPreflightParameters)) {
+			if err := d.SetNew("cors_preflight_response_parameters", corsPreflightParameters); err != nil {
+				return err
+			}
+
+			if d.Id() != "" {
+				if err := d.SetNewComputed("response_json"); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	parameters := make(map[string]bool)
-	if kv, ok := d.GetOk("response_parameters"); ok {
-		for k, v := range kv.(map[string]interface{}) {
-			parameters[k], ok = v.(bool)
-			if !ok {
-				value, _ := strconv.ParseBool(v.(string))
-				parameters[k] = value
+	if d.NewValueKnown("register_aliases") && d.NewValueKnown("response_models") {
+		aliasModels := methodResponseRegisteredAliasModels(d.Get("response_models").(map[string]interface{}), d.Get("register_aliases").(bool))
+
+		if o := d.Get("registered_alias_response_models").(map[string]interface{}); len(o) != len(aliasModels) || (len(o) > 0 && !reflect.DeepEqual(o, aliasModels)) {
+			if err := d.SetNew("registered_alias_response_models", aliasModels); err != nil {
+				return err
+			}
+
+			if d.Id() != "" {
+				if err := d.SetNewComputed("response_json"); err != nil {
+					return err
+				}
 			}
 		}
 	}
 
-	resourceMethodResponseMutex.Lock()
-	defer resourceMethodResponseMutex.Unlock()
+	// response_json mirrors what API Gateway returns, so it is only known after the changes are applied.
+	if d.Id() != "" && d.HasChanges("cors_preflight", "register_aliases", "response_models", "response_parameters") {
+		return d.SetNewComputed("response_json")
+	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
-		return conn.PutMethodResponseWithContext(ctx, &apigateway.PutMethodResponseInput{
-			HttpMethod:         aws.String(d.Get("http_method").(string)),
-			ResourceId:         aws.String(d.Get("resource_id").(string)),
-			RestApiId:          aws.String(d.Get("rest_api_id").(string)),
-			StatusCode:         aws.String(d.Get("status_code").(string)),
-			ResponseModels:     aws.StringMap(models),
-			ResponseParameters: aws.BoolMap(parameters),
-		})
-	}, apigateway.ErrCodeConflictException)
+	return nil
+}
 
-	if err != nil {
+func resourceMethodResponseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	statusCode := d.Get("status_code").(string)
+	additionalStatusCodes := flex.ExpandStringValueSet(d.Get("additional_status_codes").(*schema.Set))
+
+	if err := validateMethodResponseAdditionalStatusCodes(statusCode, additionalStatusCodes); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("agmr-%s-%s-%s-%s", d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string), d.Get("status_code").(string)))
+	models := expandMethodResponseModels(d)
+	parameters := expandMethodResponseParameters(d)
+
+	// ConflictExceptions are raised per method, so serialize method response creation for each method only.
+	// Give up waiting once the create timeout has elapsed so that a stuck call surfaces as an error.
+	mutexKey := methodResponseMutexKey(d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string))
+	if err := lockMethodResponseMutex(ctx, mutexKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response: timed out waiting for method response lock (%s): %s", mutexKey, err)
+	}
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	if err := putMethodResponse(ctx, conn, d, statusCode, models, parameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("agmr-%s-%s-%s-%s", d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string), statusCode))
 	log.Printf("[DEBUG] API Gateway Method ID: %s", d.Id())
 
-	return diags
+	d.Set("cors_preflight_response_parameters", methodResponseCORSPreflightParameters(d.Get("response_parameters").(map[string]interface{}), d.Get("cors_preflight").([]interface{})))
+	d.Set("registered_alias_response_models", methodResponseRegisteredAliasModels(d.Get("response_models").(map[string]interface{}), d.Get("register_aliases").(bool)))
+
+	for _, statusCode := range additionalStatusCodes {
+		if err := putMethodResponse(ctx, conn, d, statusCode, models, parameters); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+		}
+	}
+
+	return append(diags, resourceMethodResponseRead(ctx, d, meta)...)
 }
 
 func resourceMethodResponseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,14 +268,87 @@ func resourceMethodResponseRead(ctx context.Context, d *schema.ResourceData, met
 
 	log.Printf("[DEBUG] Received API Gateway Method Response: %s", methodResponse)
 
-	if err := d.Set("response_models", aws.StringValueMap(methodResponse.ResponseModels)); err != nil {
+	// Models registered for media type aliases are reported in registered_alias_response_models instead of response_models.
+	// A model that is missing from the method response is left out of both, so that it is registered again.
+	responseModels := aws.StringValueMap(methodResponse.ResponseModels)
+	aliasModels := make(map[string]string)
+	for k := range d.Get("registered_alias_response_models").(map[string]interface{}) {
+		if v, ok := responseModels[k]; ok {
+			aliasModels[k] = v
+			delete(responseModels, k)
+		}
+	}
+
+	responseJSON, err := flattenMethodResponseJSON(methodResponse)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Method Response (%s): %s", d.Id(), err)
+	}
+
+	d.Set("response_json", responseJSON)
+
+	// The integration response depends on the method response, so it is only checked once it exists, on later reads.
+	if d.Get("validate_against_integration_response").(bool) {
+		diags = append(diags, validateMethodResponseAgainstIntegrationResponse(ctx, conn, d, aws.BoolValueMap(methodResponse.ResponseParameters))...)
+	}
+
+	if err := d.Set("response_models", responseModels); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting response_models: %s", err)
 	}
 
-	if err := d.Set("response_parameters", aws.BoolValueMap(methodResponse.ResponseParameters)); err != nil {
+	if err := d.Set("registered_alias_response_models", aliasModels); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting registered_alias_response_models: %s", err)
+	}
+
+	// Headers added by cors_preflight are reported in cors_preflight_response_parameters instead of response_parameters.
+	// A header that is missing from the method response is left out of both, so that it is added back.
+	responseParameters := aws.BoolValueMap(methodResponse.ResponseParameters)
+	corsPreflightParameters := make(map[string]bool)
+	for k := range d.Get("cors_preflight_response_parameters").(map[string]interface{}) {
+		if v, ok := responseParameters[k]; ok {
+			corsPreflightParameters[k] = v
+			delete(responseParameters, k)
+		}
+	}
+
+	if err := d.Set("response_parameters", responseParameters); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting response_parameters: %s", err)
 	}
 
+	if err := d.Set("cors_preflight_response_parameters", corsPreflightParameters); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cors_preflight_response_parameters: %s", err)
+	}
+
+	// A method response for an additional status code whose models or parameters have drifted from those of
+	// status_code is left out so that it is re-created on the next apply.
+	var additionalStatusCodes []string
+	for _, statusCode := range flex.ExpandStringValueSet(d.Get("additional_status_codes").(*schema.Set)) {
+		additionalMethodResponse, err := conn.GetMethodResponseWithContext(ctx, &apigateway.GetMethodResponseInput{
+			HttpMethod: aws.String(d.Get("http_method").(string)),
+			ResourceId: aws.String(d.Get("resource_id").(string)),
+			RestApiId:  aws.String(d.Get("rest_api_id").(string)),
+			StatusCode: aws.String(statusCode),
+		})
+
+		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+		}
+
+		if !methodResponsesEquivalent(methodResponse, additionalMethodResponse) {
+			log.Printf("[WARN] API Gateway Method Response (%s) for status code %s differs from status code %s", d.Id(), statusCode, d.Get("status_code").(string))
+			continue
+		}
+
+		additionalStatusCodes = append(additionalStatusCodes, statusCode)
+	}
+
+	if err := d.Set("additional_status_codes", additionalStatusCodes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting additional_status_codes: %s", err)
+	}
+
 	return diags
 }
 
@@ -170,27 +359,82 @@ func resourceMethodResponseUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Updating API Gateway Method Response %s", d.Id())
 	operations := make([]*apigateway.PatchOperation, 0)
 
-	if d.HasChange("response_models") {
-		operations = append(operations, expandRequestResponseModelOperations(d, "response_models", "responseModels")...)
+	if d.HasChanges("registered_alias_response_models", "response_models") {
+		oldModels, newModels := d.GetChange("response_models")
+		oldAliasModels, newAliasModels := d.GetChange("registered_alias_response_models")
+		ops := expandRequestResponseModelMapOperations(
+			mergeMethodResponseModelAliases(oldModels.(map[string]interface{}), oldAliasModels.(map[string]interface{})),
+			mergeMethodResponseModelAliases(newModels.(map[string]interface{}), newAliasModels.(map[string]interface{})),
+			"responseModels",
+		)
+		operations = append(operations, ops...)
 	}
 
-	if d.HasChange("response_parameters") {
-		ops := expandMethodParametersOperations(d, "response_parameters", "responseParameters")
+	if d.HasChanges("cors_preflight_response_parameters", "response_parameters") {
+		oldParameters, newParameters := d.GetChange("response_parameters")
+		oldCORSPreflightParameters, newCORSPreflightParameters := d.GetChange("cors_preflight_response_parameters")
+		ops := expandMethodParametersMapOperations(
+			mergeMethodResponseCORSPreflightParameters(oldParameters.(map[string]interface{}), oldCORSPreflightParameters.(map[string]interface{})),
+			mergeMethodResponseCORSPreflightParameters(newParameters.(map[string]interface{}), newCORSPreflightParameters.(map[string]interface{})),
+			"responseParameters",
+		)
 		operations = append(operations, ops...)
 	}
 
-	_, err := conn.UpdateMethodResponseWithContext(ctx, &apigateway.UpdateMethodResponseInput{
-		HttpMethod:      aws.String(d.Get("http_method").(string)),
-		ResourceId:      aws.String(d.Get("resource_id").(string)),
-		RestApiId:       aws.String(d.Get("rest_api_id").(string)),
-		StatusCode:      aws.String(d.Get("status_code").(string)),
-		PatchOperations: operations,
-	})
+	sortPatchOperations(operations)
 
-	if err != nil {
+	o, n := d.GetChange("additional_status_codes")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	if err := validateMethodResponseAdditionalStatusCodes(d.Get("status_code").(string), flex.ExpandStringValueSet(ns)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating API Gateway Method Response (%s): %s", d.Id(), err)
 	}
 
+	// The same changes are applied to the method response for every status code that is kept.
+	statusCodes := append([]string{d.Get("status_code").(string)}, flex.ExpandStringValueSet(os.Intersection(ns))...)
+
+	for _, statusCode := range statusCodes {
+		_, err := conn.UpdateMethodResponseWithContext(ctx, &apigateway.UpdateMethodResponseInput{
+			HttpMethod:      aws.String(d.Get("http_method").(string)),
+			ResourceId:      aws.String(d.Get("resource_id").(string)),
+			RestApiId:       aws.String(d.Get("rest_api_id").(string)),
+			StatusCode:      aws.String(statusCode),
+			PatchOperations: operations,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+		}
+	}
+
+	if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+		models := expandMethodResponseModels(d)
+		parameters := expandMethodResponseParameters(d)
+
+		mutexKey := methodResponseMutexKey(d.Get("rest_api_id").(string), d.Get("resource_id").(string), d.Get("http_method").(string))
+		if err := lockMethodResponseMutex(ctx, mutexKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Method Response (%s): timed out waiting for method response lock (%s): %s", d.Id(), mutexKey, err)
+		}
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		// A status code can be added back after its method response drifted, so replace any that exists.
+		for _, statusCode := range add {
+			if err := deleteMethodResponse(ctx, conn, d, statusCode); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+			}
+
+			if err := putMethodResponse(ctx, conn, d, statusCode, models, parameters); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+			}
+		}
+	}
+
+	for _, statusCode := range flex.ExpandStringValueSet(os.Difference(ns)) {
+		if err := deleteMethodResponse(ctx, conn, d, statusCode); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+		}
+	}
+
 	return append(diags, resourceMethodResponseRead(ctx, d, meta)...)
 }
 
@@ -199,20 +443,324 @@ func resourceMethodResponseDelete(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	log.Printf("[DEBUG] Deleting API Gateway Method Response: %s", d.Id())
 
-	_, err := conn.DeleteMethodResponseWithContext(ctx, &apigateway.DeleteMethodResponseInput{
-		HttpMethod: aws.String(d.Get("http_method").(string)),
-		ResourceId: aws.String(d.Get("resource_id").(string)),
-		RestApiId:  aws.String(d.Get("rest_api_id").(string)),
-		StatusCode: aws.String(d.Get("status_code").(string)),
+	for _, statusCode := range flex.ExpandStringValueSet(d.Get("additional_status_codes").(*schema.Set)) {
+		if err := deleteMethodResponse(ctx, conn, d, statusCode); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting API Gateway Method Response (%s) for status code %s: %s", d.Id(), statusCode, err)
+		}
+	}
+
+	if err := deleteMethodResponse(ctx, conn, d, d.Get("status_code").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway Method Response (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// flattenMethodResponseJSON serializes the method response as returned by API Gateway, including any
+// models and parameters that are registered for media type aliases and cors_preflight.
+func flattenMethodResponseJSON(apiObject *apigateway.MethodResponse) (string, error) {
+	b, err := json.Marshal(struct {
+		ResponseModels     map[string]string `json:"responseModels"`
+		ResponseParameters map[string]bool   `json:"responseParameters"`
+		StatusCode         string            `json:"statusCode"`
+	}{
+		ResponseModels:     aws.StringValueMap(apiObject.ResponseModels),
+		ResponseParameters: aws.BoolValueMap(apiObject.ResponseParameters),
+		StatusCode:         aws.StringValue(apiObject.StatusCode),
 	})
 
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// lockMethodResponseMutex acquires the method response lock, giving up once the timeout has elapsed.
+// The context passed to CRUD functions has no deadline of its own, so the timeout must be applied here.
+func lockMethodResponseMutex(ctx context.Context, mutexKey string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return conns.GlobalMutexKV.LockWithContext(ctx, mutexKey)
+}
+
+// putMethodResponse creates the method response for the specified status code.
+// The caller must hold the method's method response lock.
+func putMethodResponse(ctx context.Context, conn *apigateway.APIGateway, d *schema.ResourceData, statusCode string, models map[string]string, parameters map[string]bool) error {
+	// NotFoundExceptions are retried as the method may not be visible yet if it was created at the same time.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+		return conn.PutMethodResponseWithContext(ctx, &apigateway.PutMethodResponseInput{
+			HttpMethod:         aws.String(d.Get("http_method").(string)),
+			ResourceId:         aws.String(d.Get("resource_id").(string)),
+			RestApiId:          aws.String(d.Get("rest_api_id").(string)),
+			StatusCode:         aws.String(statusCode),
+			ResponseModels:     aws.StringMap(models),
+			ResponseParameters: aws.BoolMap(parameters),
+		})
+	}, apigateway.ErrCodeConflictException, apigateway.ErrCodeNotFoundException)
+
+	return err
+}
+
+// errMessageMethodResponseIntegrationResponseExists is part of the BadRequestException message that DeleteMethodResponse
+// returns while an integration response for the status code exists.
+const errMessageMethodResponseIntegrationResponseExists = "integration response"
+
+// deleteMethodResponse deletes the method response for the specified status code, if it exists.
+func deleteMethodResponse(ctx context.Context, conn *apigateway.APIGateway, d *schema.ResourceData, statusCode string) error {
+	// A method response can't be deleted while an integration response for its status code exists,
+	// so retry until an integration response that is being destroyed at the same time is gone.
+	_, err := tfresource.RetryWhen(ctx, 2*time.Minute,
+		func() (interface{}, error) {
+			return conn.DeleteMethodResponseWithContext(ctx, &apigateway.DeleteMethodResponseInput{
+				HttpMethod: aws.String(d.Get("http_method").(string)),
+				ResourceId: aws.String(d.Get("resource_id").(string)),
+				RestApiId:  aws.String(d.Get("rest_api_id").(string)),
+				StatusCode: aws.String(statusCode),
+			})
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeConflictException) {
+				return true, err
+			}
+
+			if tfawserr.ErrMessageContains(err, apigateway.ErrCodeBadRequestException, errMessageMethodResponseIntegrationResponseExists) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
 	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// expandMethodResponseModels returns the response models to create, including any registered for media type aliases.
+func expandMethodResponseModels(d *schema.ResourceData) map[string]string {
+	models := make(map[string]string)
+	for k, v := range d.Get("response_models").(map[string]interface{}) {
+		models[k] = v.(string)
+	}
+
+	for k, v := range methodResponseRegisteredAliasModels(d.Get("response_models").(map[string]interface{}), d.Get("register_aliases").(bool)) {
+		models[k] = v.(string)
+	}
+
+	return models
+}
+
+// expandMethodResponseParameters returns the response parameters to create, including any declared by cors_preflight.
+func expandMethodResponseParameters(d *schema.ResourceData) map[string]bool {
+	parameters := make(map[string]bool)
+	if kv, ok := d.GetOk("response_parameters"); ok {
+		for k, v := range kv.(map[string]interface{}) {
+			parameters[k], ok = v.(bool)
+			if !ok {
+				value, _ := strconv.ParseBool(v.(string))
+				parameters[k] = value
+			}
+		}
+	}
+
+	for k, v := range expandMethodResponseCORSPreflightParameters(d.Get("cors_preflight").([]interface{})) {
+		if _, ok := parameters[k]; !ok {
+			parameters[k] = v
+		}
+	}
+
+	return parameters
+}
+
+// methodResponsesEquivalent returns whether two method responses declare the same models and parameters.
+func methodResponsesEquivalent(a, b *apigateway.MethodResponse) bool {
+	aModels, bModels := aws.StringValueMap(a.ResponseModels), aws.StringValueMap(b.ResponseModels)
+	if len(aModels) != len(bModels) {
+		return false
+	}
+	for k, v := range aModels {
+		if w, ok := bModels[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	aParameters, bParameters := aws.BoolValueMap(a.ResponseParameters), aws.BoolValueMap(b.ResponseParameters)
+	if len(aParameters) != len(bParameters) {
+		return false
+	}
+	for k, v := range aParameters {
+		if w, ok := bParameters[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateMethodResponseAdditionalStatusCodes(statusCode string, additionalStatusCodes []string) error {
+	for _, v := range additionalStatusCodes {
+		if v == statusCode {
+			return fmt.Errorf("additional_status_codes must not contain status_code (%s)", statusCode)
+		}
+	}
+
+	return nil
+}
+
+// methodResponseMediaTypeAliases maps a media type to the aliases that register_aliases also registers its model for.
+var methodResponseMediaTypeAliases = map[string][]string{
+	"application/json": {"text/json"},
+	"application/xml":  {"text/xml"},
+}
+
+// methodResponseModelAliases returns the models to register for the aliases of the declared media types.
+// Media types that are declared explicitly are never overridden.
+func methodResponseModelAliases(models map[string]interface{}) map[string]interface{} {
+	aliases := make(map[string]interface{})
+
+	for mediaType, model := range models {
+		for _, alias := range methodResponseMediaTypeAliases[mediaType] {
+			if _, ok := models[alias]; !ok {
+				aliases[alias] = model
+			}
+		}
+	}
+
+	return aliases
+}
+
+// methodResponseRegisteredAliasModels returns the models that register_aliases registers for response_models.
+func methodResponseRegisteredAliasModels(models map[string]interface{}, registerAliases bool) map[string]interface{} {
+	if !registerAliases {
+		return make(map[string]interface{})
+	}
+
+	return methodResponseModelAliases(models)
+}
+
+// mergeMethodResponseModelAliases returns the response models that result from combining response_models
+// with the models registered for media type aliases. Explicitly configured models take precedence.
+func mergeMethodResponseModelAliases(models, aliasModels map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+
+	for k, v := range aliasModels {
+		merged[k] = v
+	}
+
+	for k, v := range models {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// expandMethodResponseCORSPreflightParameters returns the standard CORS response headers declared by a cors_preflight block.
+func expandMethodResponseCORSPreflightParameters(tfList []interface{}) map[string]bool {
+	parameters := make(map[string]bool)
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return parameters
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for k, header := range map[string]string{
+		"allow_credentials": "Access-Control-Allow-Credentials",
+		"allow_headers":     "Access-Control-Allow-Headers",
+		"allow_methods":     "Access-Control-Allow-Methods",
+		"allow_origin":      "Access-Control-Allow-Origin",
+	} {
+		if v, ok := tfMap[k].(bool); ok && v {
+			parameters["method.response.header."+header] = false
+		}
+	}
+
+	return parameters
+}
+
+// methodResponseCORSPreflightParameters returns the headers that a cors_preflight block adds to response_parameters,
+// i.e. those that are not configured explicitly.
+func methodResponseCORSPreflightParameters(parameters map[string]interface{}, corsPreflight []interface{}) map[string]interface{} {
+	added := make(map[string]interface{})
+
+	for k, v := range expandMethodResponseCORSPreflightParameters(corsPreflight) {
+		if _, ok := parameters[k]; !ok {
+			added[k] = v
+		}
+	}
+
+	return added
+}
+
+// mergeMethodResponseCORSPreflightParameters returns the response parameters that result from combining
+// response_parameters with the headers added by cors_preflight. Explicitly configured parameters take precedence.
+func mergeMethodResponseCORSPreflightParameters(parameters, corsPreflightParameters map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+
+	for k, v := range corsPreflightParameters {
+		merged[k] = v
+	}
+
+	for k, v := range parameters {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+func methodResponseMutexKey(restAPIID, resourceID, httpMethod string) string {
+	return fmt.Sprintf("aws_api_gateway_method_response-%s-%s-%s", restAPIID, resourceID, httpMethod)
+}
+
+// validateMethodResponseAgainstIntegrationResponse returns a warning for each response header that is declared
+// by the method response but never mapped by the corresponding integration response.
+func validateMethodResponseAgainstIntegrationResponse(ctx context.Context, conn *apigateway.APIGateway, d *schema.ResourceData, parameters map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	restAPIID := d.Get("rest_api_id").(string)
+	resourceID := d.Get("resource_id").(string)
+	httpMethod := d.Get("http_method").(string)
+	statusCode := d.Get("status_code").(string)
+
+	integrationResponse, err := FindIntegrationResponse(ctx, conn, restAPIID, resourceID, httpMethod, statusCode)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[DEBUG] API Gateway Method Response (%s): no Integration Response found for status code %s, response headers not validated", d.Id(), statusCode)
 		return diags
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting API Gateway Method Response (%s): %s", d.Id(), err)
+		return sdkdiag.AppendWarningf(diags, "API Gateway Method Response (%s): reading Integration Response, response headers not validated: %s", d.Id(), err)
+	}
+
+	for _, header := range unmappedMethodResponseHeaders(parameters, aws.StringValueMap(integrationResponse.ResponseParameters)) {
+		diags = sdkdiag.AppendWarningf(diags, "API Gateway Method Response (%s) declares response header %q that the Integration Response does not map", d.Id(), header)
 	}
 
 	return diags
 }
+
+// unmappedMethodResponseHeaders returns the sorted names of the method response headers that have no integration response mapping.
+func unmappedMethodResponseHeaders(methodResponseParameters map[string]bool, integrationResponseParameters map[string]string) []string {
+	const prefix = "method.response.header."
+
+	var headers []string
+
+	for k := range methodResponseParameters {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		if _, ok := integrationResponseParameters[k]; !ok {
+			headers = append(headers, strings.TrimPrefix(k, prefix))
+		}
+	}
+
+	sort.Strings(headers)
+
+	return headers
+}
@@ -3,6 +3,7 @@ package apigateway_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,6 +38,8 @@ func TestAccAPIGatewayMethodResponse_basic(t *testing.T) {
 						resourceName, "status_code", "400"),
 					resource.TestCheckResourceAttr(
 						resourceName, "response_models.application/json", "Error"),
+					resource.TestCheckResourceAttr(
+						resourceName, "response_json", `{"responseModels":{"application/json":"Error"},"responseParameters":{"method.response.header.Content-Type":true},"statusCode":"400"}`),
 				),
 			},
 
@@ -49,6 +52,8 @@ func TestAccAPIGatewayMethodResponse_basic(t *testing.T) {
 						resourceName, "status_code", "400"),
 					resource.TestCheckResourceAttr(
 						resourceName, "response_models.application/json", "Empty"),
+					resource.TestMatchResourceAttr(
+						resourceName, "response_json", regexp.MustCompile(`"responseModels":\{"application/json":"Empty"\}`)),
 				),
 			},
 			{
@@ -56,6 +61,47 @@ func TestAccAPIGatewayMethodResponse_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateIdFunc: testAccMethodResponseImportStateIdFunc(resourceName),
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cors_preflight",
+					"register_aliases",
+					"validate_against_integration_response",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayMethodResponse_responseParametersAddRemove(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.MethodResponse
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_api_gateway_method_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodResponseConfig_responseParameters(rName, "method.response.header.X-Old", "method.response.header.Content-Type"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.method.response.header.X-Old", "true"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.method.response.header.Content-Type", "true"),
+				),
+			},
+			{
+				// Removes X-Old and adds X-New in the same UpdateMethodResponse batch. Content-Type is unchanged, so no operation is sent for it.
+				Config: testAccMethodResponseConfig_responseParameters(rName, "method.response.header.X-New", "method.response.header.Content-Type"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseParameters(&conf, []string{"method.response.header.Content-Type", "method.response.header.X-New"}),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.method.response.header.X-New", "true"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.method.response.header.Content-Type", "true"),
+				),
 			},
 		},
 	})
@@ -85,6 +131,215 @@ func TestAccAPIGatewayMethodResponse_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayMethodResponse_corsPreflight(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.MethodResponse
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_api_gateway_method_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodResponseConfig_corsPreflight(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseParameters(&conf, []string{
+						"method.response.header.Access-Control-Allow-Headers",
+						"method.response.header.Access-Control-Allow-Methods",
+						"method.response.header.Access-Control-Allow-Origin",
+						"method.response.header.Content-Type",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cors_preflight.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_preflight_response_parameters.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "1"),
+				),
+			},
+			{
+				Config: testAccMethodResponseConfig_corsPreflight(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseParameters(&conf, []string{
+						"method.response.header.Access-Control-Allow-Credentials",
+						"method.response.header.Access-Control-Allow-Headers",
+						"method.response.header.Access-Control-Allow-Methods",
+						"method.response.header.Access-Control-Allow-Origin",
+						"method.response.header.Content-Type",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cors_preflight.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_preflight_response_parameters.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "1"),
+				),
+			},
+			{
+				// A header added by cors_preflight that is removed outside of Terraform is added back.
+				Config: testAccMethodResponseConfig_corsPreflight(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseUpdate(ctx, resourceName, &apigateway.PatchOperation{
+						Op:   aws.String(apigateway.OpRemove),
+						Path: aws.String("/responseParameters/method.response.header.Access-Control-Allow-Origin"),
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccMethodResponseConfig_corsPreflight(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseParameters(&conf, []string{
+						"method.response.header.Access-Control-Allow-Credentials",
+						"method.response.header.Access-Control-Allow-Headers",
+						"method.response.header.Access-Control-Allow-Methods",
+						"method.response.header.Access-Control-Allow-Origin",
+						"method.response.header.Content-Type",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cors_preflight_response_parameters.%", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayMethodResponse_registerAliases(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.MethodResponse
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_api_gateway_method_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodResponseConfig_registerAliases(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseModels(&conf, map[string]string{
+						"application/json": "Empty",
+						"text/json":        "Empty",
+						"application/xml":  "Error",
+						"text/xml":         "Error",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "register_aliases", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registered_alias_response_models.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "response_models.%", "2"),
+				),
+			},
+			{
+				// A model registered for an alias that is removed outside of Terraform is registered again.
+				Config: testAccMethodResponseConfig_registerAliases(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseUpdate(ctx, resourceName, &apigateway.PatchOperation{
+						Op:   aws.String(apigateway.OpRemove),
+						Path: aws.String("/responseModels/text~1json"),
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccMethodResponseConfig_registerAliases(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseModels(&conf, map[string]string{
+						"application/json": "Empty",
+						"text/json":        "Empty",
+						"application/xml":  "Error",
+						"text/xml":         "Error",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "registered_alias_response_models.%", "2"),
+				),
+			},
+			{
+				Config: testAccMethodResponseConfig_registerAliases(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					testAccCheckMethodResponseModels(&conf, map[string]string{
+						"application/json": "Empty",
+						"application/xml":  "Error",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "register_aliases", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registered_alias_response_models.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "response_models.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayMethodResponse_additionalStatusCodes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.MethodResponse
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_api_gateway_method_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMethodResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodResponseConfig_additionalStatusCodes(rName, `"400", "500"`, "method.response.header.Content-Type"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "additional_status_codes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "additional_status_codes.*", "400"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "additional_status_codes.*", "500"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "1"),
+				),
+			},
+			{
+				Config: testAccMethodResponseConfig_additionalStatusCodes(rName, `"400", "404"`, "method.response.header.X-Request-Id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMethodResponseExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "additional_status_codes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "additional_status_codes.*", "400"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "additional_status_codes.*", "404"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "response_parameters.method.response.header.X-Request-Id", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMethodResponseModels(conf *apigateway.MethodResponse, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, want := len(conf.ResponseModels), len(expected); got != want {
+			return fmt.Errorf("Unexpected number of ResponseModels: got %d, want %d", got, want)
+		}
+
+		for k, want := range expected {
+			if got := aws.StringValue(conf.ResponseModels[k]); got != want {
+				return fmt.Errorf("Unexpected ResponseModels[%q]: got %q, want %q", k, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMethodResponseParameters(conf *apigateway.MethodResponse, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, want := len(conf.ResponseParameters), len(expected); got != want {
+			return fmt.Errorf("Unexpected number of ResponseParameters: got %d, want %d", got, want)
+		}
+
+		for _, k := range expected {
+			if _, ok := conf.ResponseParameters[k]; !ok {
+				return fmt.Errorf("ResponseParameters does not contain %s", k)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckMethodResponseAttributes(conf *apigateway.MethodResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *conf.StatusCode == "" {
@@ -157,6 +412,28 @@ func testAccCheckMethodResponseExists(ctx context.Context, n string, res *apigat
 	}
 }
 
+// testAccCheckMethodResponseUpdate applies the patch operations to the method response outside of Terraform.
+func testAccCheckMethodResponseUpdate(ctx context.Context, n string, operations ...*apigateway.PatchOperation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
+
+		_, err := conn.UpdateMethodResponseWithContext(ctx, &apigateway.UpdateMethodResponseInput{
+			HttpMethod:      aws.String(rs.Primary.Attributes["http_method"]),
+			ResourceId:      aws.String(rs.Primary.Attributes["resource_id"]),
+			RestApiId:       aws.String(rs.Primary.Attributes["rest_api_id"]),
+			StatusCode:      aws.String(rs.Primary.Attributes["status_code"]),
+			PatchOperations: operations,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckMethodResponseDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
@@ -283,3 +560,139 @@ resource "aws_api_gateway_method_response" "error" {
 }
 `, rName)
 }
+
+func testAccMethodResponseConfig_corsPreflight(rName string, allowCredentials bool) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  resource_id   = aws_api_gateway_resource.test.id
+  http_method   = "OPTIONS"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_method_response" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  resource_id = aws_api_gateway_resource.test.id
+  http_method = aws_api_gateway_method.test.http_method
+  status_code = "200"
+
+  response_parameters = {
+    "method.response.header.Content-Type" = true
+  }
+
+  cors_preflight {
+    allow_credentials = %[2]t
+  }
+}
+`, rName, allowCredentials)
+}
+
+func testAccMethodResponseConfig_registerAliases(rName string, registerAliases bool) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  resource_id   = aws_api_gateway_resource.test.id
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_method_response" "test" {
+  rest_api_id      = aws_api_gateway_rest_api.test.id
+  resource_id      = aws_api_gateway_resource.test.id
+  http_method      = aws_api_gateway_method.test.http_method
+  status_code      = "200"
+  register_aliases = %[2]t
+
+  response_models = {
+    "application/json" = "Empty"
+    "application/xml"  = "Error"
+  }
+}
+`, rName, registerAliases)
+}
+
+func testAccMethodResponseConfig_responseParameters(rName, parameter1, parameter2 string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  resource_id   = aws_api_gateway_resource.test.id
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_method_response" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  resource_id = aws_api_gateway_resource.test.id
+  http_method = aws_api_gateway_method.test.http_method
+  status_code = "200"
+
+  response_parameters = {
+    %[2]q = true
+    %[3]q = true
+  }
+}
+`, rName, parameter1, parameter2)
+}
+
+func testAccMethodResponseConfig_additionalStatusCodes(rName, additionalStatusCodes, parameter string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  parent_id   = aws_api_gateway_rest_api.test.root_resource_id
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  resource_id   = aws_api_gateway_resource.test.id
+  http_method   = "GET"
+  authorization = "NONE"
+}
+
+resource "aws_api_gateway_method_response" "test" {
+  rest_api_id             = aws_api_gateway_rest_api.test.id
+  resource_id             = aws_api_gateway_resource.test.id
+  http_method             = aws_api_gateway_method.test.http_method
+  status_code             = "200"
+  additional_status_codes = [%[2]s]
+
+  response_parameters = {
+    %[3]q = true
+  }
+}
+`, rName, additionalStatusCodes, parameter)
+}
@@ -2,6 +2,7 @@ package apigateway
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,21 @@ func validIntegrationContentHandling() schema.SchemaValidateFunc {
 	}, false)
 }
 
+// validMethodResponseModels validates the content types that response models are keyed by.
+// PutMethodResponse rejects empty and wildcard-only content types.
+func validMethodResponseModels(v interface{}, k string) (ws []string, errors []error) {
+	for contentType := range v.(map[string]interface{}) {
+		switch strings.TrimSpace(contentType) {
+		case "":
+			errors = append(errors, fmt.Errorf("%s: content type must not be empty", k))
+		case "*", "*/*":
+			errors = append(errors, fmt.Errorf("%s: content type (%s) must not be a wildcard", k, contentType))
+		}
+	}
+
+	return
+}
+
 func validUsagePlanQuotaSettings(v map[string]interface{}) (errors []error) {
 	period := v["period"].(string)
 	offset := v["offset"].(int)
@@ -80,3 +80,48 @@ func TestValidUsagePlanQuotaSettings(t *testing.T) {
 		}
 	}
 }
+
+func TestValidMethodResponseModels(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Models   map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Models:   map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Models:   map[string]interface{}{"application/json": "Empty"},
+			ErrCount: 0,
+		},
+		{
+			Models:   map[string]interface{}{"application/json": "Empty", "text/xml": "Empty"},
+			ErrCount: 0,
+		},
+		{
+			Models:   map[string]interface{}{"": "Empty"},
+			ErrCount: 1,
+		},
+		{
+			Models:   map[string]interface{}{" ": "Empty"},
+			ErrCount: 1,
+		},
+		{
+			Models:   map[string]interface{}{"*": "Empty"},
+			ErrCount: 1,
+		},
+		{
+			Models:   map[string]interface{}{"*/*": "Empty", "application/json": "Empty"},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validMethodResponseModels(tc.Models, "response_models")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("API Gateway Method Response models validation failed for %v: %v", tc.Models, errors)
+		}
+	}
+}
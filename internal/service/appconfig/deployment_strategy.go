@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,7 +37,7 @@ func ResourceDeploymentStrategy() *schema.Resource {
 			"deployment_duration_in_minutes": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validation.IntBetween(0, 1440),
+				ValidateFunc: validation.IntBetween(0, deploymentStrategyMaxDurationInMinutes),
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -46,12 +47,12 @@ func ResourceDeploymentStrategy() *schema.Resource {
 			"final_bake_time_in_minutes": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.IntBetween(0, 1440),
+				ValidateFunc: validation.IntBetween(0, deploymentStrategyMaxDurationInMinutes),
 			},
 			"growth_factor": {
 				Type:         schema.TypeFloat,
 				Required:     true,
-				ValidateFunc: validation.FloatBetween(1.0, 100.0),
+				ValidateFunc: validation.FloatBetween(deploymentStrategyMinGrowthFactor, deploymentStrategyMaxGrowthFactor),
 			},
 			"growth_type": {
 				Type:         schema.TypeString,
@@ -74,10 +75,41 @@ func ResourceDeploymentStrategy() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceDeploymentStrategyCustomizeDiff,
+		),
 	}
 }
 
+const (
+	deploymentStrategyMaxDurationInMinutes = 1440
+	deploymentStrategyMinGrowthFactor      = 1.0
+	deploymentStrategyMaxGrowthFactor      = 100.0
+)
+
+// resourceDeploymentStrategyCustomizeDiff repeats the schema validation at plan time,
+// for values such as data source attributes that are unknown when the configuration is validated.
+func resourceDeploymentStrategyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("growth_factor") {
+		if v := d.Get("growth_factor").(float64); v < deploymentStrategyMinGrowthFactor || v > deploymentStrategyMaxGrowthFactor {
+			return fmt.Errorf("growth_factor (%g) must be between %g and %g", v, deploymentStrategyMinGrowthFactor, deploymentStrategyMaxGrowthFactor)
+		}
+	}
+
+	for _, k := range []string{"deployment_duration_in_minutes", "final_bake_time_in_minutes"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		if v := d.Get(k).(int); v < 0 || v > deploymentStrategyMaxDurationInMinutes {
+			return fmt.Errorf("%s (%d) must be between 0 and %d", k, v, deploymentStrategyMaxDurationInMinutes)
+		}
+	}
+
+	return nil
+}
+
 func resourceDeploymentStrategyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppConfigConn()
@@ -153,6 +153,24 @@ func TestAccAppConfigDeploymentStrategy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigDeploymentStrategy_growthFactorKnownAtPlan(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentStrategyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentStrategyConfig_growthFactorKnownAtPlan(rName),
+				ExpectError: regexp.MustCompile(`growth_factor \(\d+\) must be between 1 and 100`),
+			},
+		},
+	})
+}
+
 func TestAccAppConfigDeploymentStrategy_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -296,6 +314,19 @@ resource "aws_appconfig_deployment_strategy" "test" {
 `, rName, time)
 }
 
+func testAccDeploymentStrategyConfig_growthFactorKnownAtPlan(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_appconfig_deployment_strategy" "test" {
+  name                           = %[1]q
+  deployment_duration_in_minutes = 3
+  growth_factor                  = length(data.aws_region.current.name) * 100
+  replicate_to                   = "NONE"
+}
+`, rName)
+}
+
 func testAccDeploymentStrategyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_appconfig_deployment_strategy" "test" {
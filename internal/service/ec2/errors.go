@@ -107,6 +107,10 @@ const (
 	errCodeVolumeInUse                                    = "VolumeInUse"
 )
 
+const (
+	errCodeInvalidTransitGatewayRouteTableAnnouncementIdNotFound = "InvalidTransitGatewayRouteTableAnnouncementId.NotFound"
+)
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
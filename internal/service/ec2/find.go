@@ -4763,6 +4763,83 @@ func FindTransitGatewayRouteTableByID(ctx context.Context, conn *ec2.EC2, id str
 	return output, nil
 }
 
+func FindTransitGatewayRouteTableAnnouncement(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	output, err := FindTransitGatewayRouteTableAnnouncements(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindTransitGatewayRouteTableAnnouncements(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput) ([]*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	var output []*ec2.TransitGatewayRouteTableAnnouncement
+
+	err := conn.DescribeTransitGatewayRouteTableAnnouncementsPagesWithContext(ctx, input, func(page *ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayRouteTableAnnouncements {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayRouteTableAnnouncementIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayRouteTableAnnouncementByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	input := &ec2.DescribeTransitGatewayRouteTableAnnouncementsInput{
+		TransitGatewayRouteTableAnnouncementIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindTransitGatewayRouteTableAnnouncement(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.TransitGatewayRouteTableAnnouncementStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayRouteTableAnnouncementId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindTransitGatewayPolicyTableAssociationByTwoPartKey(ctx context.Context, conn *ec2.EC2, transitGatewayPolicyTableID, transitGatewayAttachmentID string) (*ec2.TransitGatewayPolicyTableAssociation, error) {
 	input := &ec2.GetTransitGatewayPolicyTableAssociationsInput{
 		Filters: BuildAttributeFilterList(map[string]string{
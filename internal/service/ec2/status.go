@@ -792,6 +792,22 @@ func StatusTransitGatewayPolicyTableAssociationState(ctx context.Context, conn *
 	}
 }
 
+func StatusTransitGatewayRouteTableAnnouncementState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTransitGatewayRouteTableAnnouncementByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusTransitGatewayRouteTableAssociationState(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTransitGatewayRouteTableAssociationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
@@ -1218,7 +1234,8 @@ func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id
 }
 
 const (
-	VPCEndpointRouteTableAssociationStatusReady = "ready"
+	VPCEndpointRouteTableAssociationStatusReady    = "ready"
+	VPCEndpointSecurityGroupAssociationStatusReady = "ready"
 )
 
 func StatusVPCEndpointRouteTableAssociation(ctx context.Context, conn *ec2.EC2, vpcEndpointID, routeTableID string) resource.StateRefreshFunc {
@@ -1237,6 +1254,22 @@ func StatusVPCEndpointRouteTableAssociation(ctx context.Context, conn *ec2.EC2,
 	}
 }
 
+func StatusVPCEndpointSecurityGroupAssociation(ctx context.Context, conn *ec2.EC2, vpcEndpointID, securityGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		err := FindVPCEndpointSecurityGroupAssociationExists(ctx, conn, vpcEndpointID, securityGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return "", VPCEndpointSecurityGroupAssociationStatusReady, nil
+	}
+}
+
 func StatusEBSSnapshotImport(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindImportSnapshotTaskByID(ctx, conn, id)
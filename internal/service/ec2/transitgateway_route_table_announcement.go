@@ -0,0 +1,183 @@
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransitGatewayRouteTableAnnouncement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRouteTableAnnouncementCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRouteTableAnnouncementRead,
+		UpdateWithoutTimeout: resourceTransitGatewayRouteTableAnnouncementUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayRouteTableAnnouncementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"announcement_direction": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_core_network_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peering_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTableAnnouncementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	input := &ec2.CreateTransitGatewayRouteTableAnnouncementInput{
+		PeeringAttachmentId:        aws.String(d.Get("peering_attachment_id").(string)),
+		TagSpecifications:          tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayRouteTableAnnouncement),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Route Table Announcement: %s", input)
+	output, err := conn.CreateTransitGatewayRouteTableAnnouncementWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Route Table (%s) Announcement: %s", transitGatewayRouteTableID, err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayRouteTableAnnouncement.TransitGatewayRouteTableAnnouncementId))
+
+	if _, err := WaitTransitGatewayRouteTableAnnouncementCreated(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route Table Announcement (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayRouteTableAnnouncementRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTableAnnouncementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	transitGatewayRouteTableAnnouncement, err := FindTransitGatewayRouteTableAnnouncementByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table Announcement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Announcement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("announcement_direction", transitGatewayRouteTableAnnouncement.AnnouncementDirection)
+	d.Set("core_network_id", transitGatewayRouteTableAnnouncement.CoreNetworkId)
+	d.Set("peer_core_network_id", transitGatewayRouteTableAnnouncement.PeerCoreNetworkId)
+	d.Set("peer_transit_gateway_id", transitGatewayRouteTableAnnouncement.PeerTransitGatewayId)
+	d.Set("peering_attachment_id", transitGatewayRouteTableAnnouncement.PeeringAttachmentId)
+	d.Set("state", transitGatewayRouteTableAnnouncement.State)
+	d.Set("transit_gateway_id", transitGatewayRouteTableAnnouncement.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableAnnouncement.TransitGatewayRouteTableId)
+
+	tags := KeyValueTags(transitGatewayRouteTableAnnouncement.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTableAnnouncementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table Announcement (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTableAnnouncementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Announcement: %s", d.Id())
+	_, err := conn.DeleteTransitGatewayRouteTableAnnouncementWithContext(ctx, &ec2.DeleteTransitGatewayRouteTableAnnouncementInput{
+		TransitGatewayRouteTableAnnouncementId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayRouteTableAnnouncementIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Route Table Announcement (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitTransitGatewayRouteTableAnnouncementDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route Table Announcement (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,245 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTransitGatewayRouteTableAnnouncement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTableAnnouncement1 ec2.TransitGatewayRouteTableAnnouncement
+	resourceName := "aws_ec2_transit_gateway_route_table_announcement.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayResourceNamePeer := "aws_ec2_transit_gateway.peer"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayPeeringAttachmentAccepterResourceName := "aws_ec2_transit_gateway_peering_attachment_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableAnnouncementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableAnnouncementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx, resourceName, &transitGatewayRouteTableAnnouncement1),
+					resource.TestCheckResourceAttrSet(resourceName, "announcement_direction"),
+					resource.TestCheckResourceAttr(resourceName, "core_network_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", transitGatewayResourceNamePeer, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "peering_attachment_id", transitGatewayPeeringAttachmentAccepterResourceName, "transit_gateway_attachment_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				Config:            testAccTransitGatewayRouteTableAnnouncementConfig_basic(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableAnnouncement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTableAnnouncement1 ec2.TransitGatewayRouteTableAnnouncement
+	resourceName := "aws_ec2_transit_gateway_route_table_announcement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableAnnouncementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableAnnouncementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx, resourceName, &transitGatewayRouteTableAnnouncement1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayRouteTableAnnouncement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableAnnouncement_Tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTableAnnouncement1 ec2.TransitGatewayRouteTableAnnouncement
+	resourceName := "aws_ec2_transit_gateway_route_table_announcement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableAnnouncementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableAnnouncementConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx, resourceName, &transitGatewayRouteTableAnnouncement1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config:            testAccTransitGatewayRouteTableAnnouncementConfig_tags1(rName, "key1", "value1"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTableAnnouncementConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx, resourceName, &transitGatewayRouteTableAnnouncement1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableAnnouncementConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx, resourceName, &transitGatewayRouteTableAnnouncement1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTableAnnouncementExists(ctx context.Context, n string, v *ec2.TransitGatewayRouteTableAnnouncement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route Table Announcement ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindTransitGatewayRouteTableAnnouncementByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableAnnouncementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_route_table_announcement" {
+				continue
+			}
+
+			_, err := tfec2.FindTransitGatewayRouteTableAnnouncementByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Transit Gateway Route Table Announcement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRouteTableAnnouncementConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		testAccTransitGatewayPeeringAttachmentAccepterConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_peering_attachment_accepter" "test" {
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_peering_attachment.test.id
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableAnnouncementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAnnouncementConfig_base(rName), `
+resource "aws_ec2_transit_gateway_route_table_announcement" "test" {
+  peering_attachment_id          = aws_ec2_transit_gateway_peering_attachment_accepter.test.transit_gateway_attachment_id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableAnnouncementConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAnnouncementConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table_announcement" "test" {
+  peering_attachment_id          = aws_ec2_transit_gateway_peering_attachment_accepter.test.transit_gateway_attachment_id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTransitGatewayRouteTableAnnouncementConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAnnouncementConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table_announcement" "test" {
+  peering_attachment_id          = aws_ec2_transit_gateway_peering_attachment_accepter.test.transit_gateway_attachment_id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
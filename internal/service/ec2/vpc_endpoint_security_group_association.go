@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -20,6 +21,9 @@ func ResourceVPCEndpointSecurityGroupAssociation() *schema.Resource {
 		CreateWithoutTimeout: resourceVPCEndpointSecurityGroupAssociationCreate,
 		ReadWithoutTimeout:   resourceVPCEndpointSecurityGroupAssociationRead,
 		DeleteWithoutTimeout: resourceVPCEndpointSecurityGroupAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVPCEndpointSecurityGroupAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"replace_default_association": {
@@ -86,7 +90,27 @@ func resourceVPCEndpointSecurityGroupAssociationCreate(ctx context.Context, d *s
 		}
 	}
 
-	err := createVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID)
+	var err error
+
+	if replaceDefaultAssociation {
+		err = replaceVPCEndpointDefaultSecurityGroupAssociation(ctx, securityGroupID, defaultSecurityGroupID,
+			func(ctx context.Context, securityGroupID string) error {
+				return createVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID)
+			},
+			func(ctx context.Context, securityGroupID string) error {
+				if err := WaitVPCEndpointSecurityGroupAssociationReady(ctx, conn, vpcEndpointID, securityGroupID); err != nil {
+					return fmt.Errorf("waiting for VPC Endpoint (%s) Security Group (%s) Association create: %w", vpcEndpointID, securityGroupID, err)
+				}
+
+				return nil
+			},
+			func(ctx context.Context, securityGroupID string) error {
+				return deleteVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID)
+			},
+		)
+	} else {
+		err = createVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID)
+	}
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -94,13 +118,6 @@ func resourceVPCEndpointSecurityGroupAssociationCreate(ctx context.Context, d *s
 
 	d.SetId(VPCEndpointSecurityGroupAssociationCreateID(vpcEndpointID, securityGroupID))
 
-	if replaceDefaultAssociation {
-		// Delete the existing VPC endpoint/default security group association.
-		if err := deleteVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, defaultSecurityGroupID); err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-	}
-
 	return append(diags, resourceVPCEndpointSecurityGroupAssociationRead(ctx, d, meta)...)
 }
 
@@ -151,12 +168,19 @@ func resourceVPCEndpointSecurityGroupAssociationDelete(ctx context.Context, d *s
 			return sdkdiag.AppendErrorf(diags, "reading EC2 VPC (%s) default Security Group: %s", vpcID, err)
 		}
 
+		defaultSecurityGroupID := aws.StringValue(defaultSecurityGroup.GroupId)
+
 		// Add back the VPC endpoint/default security group association.
-		err = createVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, aws.StringValue(defaultSecurityGroup.GroupId))
+		err = createVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, defaultSecurityGroupID)
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		// Only detach this security group once the default association is visible, so that the VPC endpoint always has a security group.
+		if err := WaitVPCEndpointSecurityGroupAssociationReady(ctx, conn, vpcEndpointID, defaultSecurityGroupID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for VPC Endpoint (%s) Security Group (%s) Association create: %s", vpcEndpointID, defaultSecurityGroupID, err)
+		}
 	}
 
 	if err := deleteVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID); err != nil {
@@ -165,6 +189,51 @@ func resourceVPCEndpointSecurityGroupAssociationDelete(ctx context.Context, d *s
 	return diags
 }
 
+func resourceVPCEndpointSecurityGroupAssociationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("wrong format of import ID (%s), use: 'vpc-endpoint-id/security-group-id'", d.Id())
+	}
+
+	vpcEndpointID := parts[0]
+	securityGroupID := parts[1]
+	log.Printf("[DEBUG] Importing VPC Endpoint (%s) Security Group (%s) Association", vpcEndpointID, securityGroupID)
+
+	// Whether this association replaced the default association can't be told from the VPC endpoint,
+	// so replace_default_association is left to the configuration.
+	d.SetId(VPCEndpointSecurityGroupAssociationCreateID(vpcEndpointID, securityGroupID))
+	d.Set("replace_default_association", false)
+	d.Set("security_group_id", securityGroupID)
+	d.Set("vpc_endpoint_id", vpcEndpointID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// replaceVPCEndpointDefaultSecurityGroupAssociation associates a security group with a VPC endpoint in place of the VPC's
+// default security group. The default security group is only disassociated once the new association is ready, so that the
+// VPC endpoint always has a security group. If either step fails, the new association is rolled back.
+func replaceVPCEndpointDefaultSecurityGroupAssociation(ctx context.Context, securityGroupID, defaultSecurityGroupID string, associate, waitReady, disassociate func(context.Context, string) error) error {
+	if err := associate(ctx, securityGroupID); err != nil {
+		return err
+	}
+
+	err := waitReady(ctx, securityGroupID)
+
+	if err == nil {
+		err = disassociate(ctx, defaultSecurityGroupID)
+	}
+
+	if err != nil {
+		if rollbackErr := disassociate(ctx, securityGroupID); rollbackErr != nil {
+			return fmt.Errorf("%w; rolling back: %s", err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 // createVPCEndpointSecurityGroupAssociation creates the specified VPC endpoint/security group association.
 func createVPCEndpointSecurityGroupAssociation(ctx context.Context, conn *ec2.EC2, vpcEndpointID, securityGroupID string) error {
 	input := &ec2.ModifyVpcEndpointInput{
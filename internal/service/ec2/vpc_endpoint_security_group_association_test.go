@@ -2,7 +2,9 @@ package ec2_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +17,71 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestReplaceVPCEndpointDefaultSecurityGroupAssociation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		FailStep      string
+		ExpectedSteps []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "success",
+			ExpectedSteps: []string{"associate sg-new", "wait sg-new", "disassociate sg-default"},
+		},
+		{
+			Name:          "associate fails",
+			FailStep:      "associate sg-new",
+			ExpectedSteps: []string{"associate sg-new"},
+			ExpectError:   true,
+		},
+		{
+			Name:          "wait fails",
+			FailStep:      "wait sg-new",
+			ExpectedSteps: []string{"associate sg-new", "wait sg-new", "disassociate sg-new"},
+			ExpectError:   true,
+		},
+		{
+			Name:          "disassociate default fails",
+			FailStep:      "disassociate sg-default",
+			ExpectedSteps: []string{"associate sg-new", "wait sg-new", "disassociate sg-default", "disassociate sg-new"},
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var steps []string
+			step := func(name string) func(context.Context, string) error {
+				return func(_ context.Context, securityGroupID string) error {
+					step := name + " " + securityGroupID
+					steps = append(steps, step)
+
+					if step == testCase.FailStep {
+						return errors.New("failed")
+					}
+
+					return nil
+				}
+			}
+
+			err := tfec2.ReplaceVPCEndpointDefaultSecurityGroupAssociation(context.Background(), "sg-new", "sg-default", step("associate"), step("wait"), step("disassociate"))
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+
+			if !reflect.DeepEqual(steps, testCase.ExpectedSteps) {
+				t.Errorf("got steps %q, expected %q", steps, testCase.ExpectedSteps)
+			}
+		})
+	}
+}
+
 func TestAccVPCEndpointSecurityGroupAssociation_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.VpcEndpoint
@@ -32,8 +99,15 @@ func TestAccVPCEndpointSecurityGroupAssociation_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCEndpointSecurityGroupAssociationExists(ctx, resourceName, &v),
 					testAccCheckVPCEndpointSecurityGroupAssociationNumAssociations(&v, 2),
+					resource.TestCheckResourceAttr(resourceName, "replace_default_association", "false"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccVPCEndpointSecurityGroupAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -106,6 +180,48 @@ func TestAccVPCEndpointSecurityGroupAssociation_replaceDefaultAssociation(t *tes
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCEndpointSecurityGroupAssociationExists(ctx, resourceName, &v),
 					testAccCheckVPCEndpointSecurityGroupAssociationNumAssociations(&v, 1),
+					resource.TestCheckResourceAttr(resourceName, "replace_default_association", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccVPCEndpointSecurityGroupAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace_default_association"},
+			},
+		},
+	})
+}
+
+// The replaced association restores the default association before it is removed, and the new association
+// removes the default association only once it is attached, so the VPC endpoint always has a security group.
+func TestAccVPCEndpointSecurityGroupAssociation_replaceDefaultAssociationReplace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint_security_group_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointSecurityGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointSecurityGroupAssociationConfig_replaceDefaultIndex(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointSecurityGroupAssociationExists(ctx, resourceName, &v),
+					testAccCheckVPCEndpointSecurityGroupAssociationNumAssociations(&v, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test.0", "id"),
+				),
+			},
+			{
+				Config: testAccVPCEndpointSecurityGroupAssociationConfig_replaceDefaultIndex(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointSecurityGroupAssociationExists(ctx, resourceName, &v),
+					testAccCheckVPCEndpointSecurityGroupAssociationNumAssociations(&v, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test.1", "id"),
 				),
 			},
 		},
@@ -179,6 +295,18 @@ func testAccCheckVPCEndpointSecurityGroupAssociationNumAssociations(v *ec2.VpcEn
 	}
 }
 
+func testAccVPCEndpointSecurityGroupAssociationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		id := fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_endpoint_id"], rs.Primary.Attributes["security_group_id"])
+		return id, nil
+	}
+}
+
 func testAccVPCEndpointSecurityGroupAssociationConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -249,3 +377,16 @@ resource "aws_vpc_endpoint_security_group_association" "test" {
 }
 `)
 }
+
+func testAccVPCEndpointSecurityGroupAssociationConfig_replaceDefaultIndex(rName string, index int) string {
+	return acctest.ConfigCompose(
+		testAccVPCEndpointSecurityGroupAssociationConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_vpc_endpoint_security_group_association" "test" {
+  vpc_endpoint_id   = aws_vpc_endpoint.test.id
+  security_group_id = aws_security_group.test[%[1]d].id
+
+  replace_default_association = true
+}
+`, index))
+}
@@ -1380,6 +1380,45 @@ const (
 	TransitGatewayPolicyTableDeletedTimeout = 10 * time.Minute
 )
 
+const (
+	TransitGatewayRouteTableAnnouncementCreatedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableAnnouncementDeletedTimeout = 10 * time.Minute
+)
+
+func WaitTransitGatewayRouteTableAnnouncementCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableAnnouncementStatePending},
+		Target:  []string{ec2.TransitGatewayRouteTableAnnouncementStateAvailable},
+		Timeout: TransitGatewayRouteTableAnnouncementCreatedTimeout,
+		Refresh: StatusTransitGatewayRouteTableAnnouncementState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAnnouncement); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayRouteTableAnnouncementDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableAnnouncementStateAvailable, ec2.TransitGatewayRouteTableAnnouncementStateDeleting},
+		Target:  []string{},
+		Timeout: TransitGatewayRouteTableAnnouncementDeletedTimeout,
+		Refresh: StatusTransitGatewayRouteTableAnnouncementState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAnnouncement); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayPolicyTableStatePending},
@@ -2693,6 +2732,20 @@ func WaitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.EC
 	return err
 }
 
+func WaitVPCEndpointSecurityGroupAssociationReady(ctx context.Context, conn *ec2.EC2, vpcEndpointID, securityGroupID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{VPCEndpointSecurityGroupAssociationStatusReady},
+		Refresh:                   StatusVPCEndpointSecurityGroupAssociation(ctx, conn, vpcEndpointID, securityGroupID),
+		Timeout:                   propagationTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func WaitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.EC2, importTaskID string, timeout time.Duration) (*ec2.SnapshotTaskDetail, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
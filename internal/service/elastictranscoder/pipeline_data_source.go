@@ -0,0 +1,238 @@
+package elastictranscoder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePipeline() *schema.Resource {
+	outputConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bucket": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"storage_class": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePipelineRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_config": outputConfigSchema(),
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"input_bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mediaconvert": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbnail_destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thumbnail_storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"completed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"progressing": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"warning": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"output_bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"thumbnail_config": outputConfigSchema(),
+		},
+	}
+}
+
+func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn()
+
+	id := d.Get("id").(string)
+	resp, err := conn.ReadPipelineWithContext(ctx, &elastictranscoder.ReadPipelineInput{
+		Id: aws.String(id),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): %s", id, err)
+	}
+
+	if resp == nil || resp.Pipeline == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): empty response", id)
+	}
+
+	pipeline := resp.Pipeline
+
+	d.SetId(aws.StringValue(pipeline.Id))
+	d.Set("arn", pipeline.Arn)
+	d.Set("aws_kms_key_arn", pipeline.AwsKmsKeyArn)
+	if err := d.Set("content_config", flattenETPipelineOutputConfig(pipeline.ContentConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting content_config: %s", err)
+	}
+	d.Set("input_bucket", pipeline.InputBucket)
+	if err := d.Set("mediaconvert", []interface{}{flattenPipelineMediaConvert(pipeline)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mediaconvert: %s", err)
+	}
+	d.Set("name", pipeline.Name)
+	if err := d.Set("notifications", flattenETNotifications(pipeline.Notifications)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notifications: %s", err)
+	}
+	d.Set("output_bucket", pipeline.OutputBucket)
+	d.Set("role", pipeline.Role)
+	d.Set("status", pipeline.Status)
+	if err := d.Set("thumbnail_config", flattenETPipelineOutputConfig(pipeline.ThumbnailConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting thumbnail_config: %s", err)
+	}
+
+	diags = sdkdiag.AppendWarningf(diags, "Elastic Transcoder is being discontinued. Use the mediaconvert attribute of this data source to configure an AWS Elemental MediaConvert queue and jobs for Elastic Transcoder Pipeline (%s).", d.Id())
+
+	return diags
+}
+
+// flattenPipelineMediaConvert returns the pipeline's settings in the form that AWS Elemental MediaConvert uses,
+// to help with moving pipelines to MediaConvert queues and job settings.
+func flattenPipelineMediaConvert(pipeline *elastictranscoder.Pipeline) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"input_location": pipelineS3URL(aws.StringValue(pipeline.InputBucket)),
+		"kms_key_arn":    aws.StringValue(pipeline.AwsKmsKeyArn),
+		"queue_name":     aws.StringValue(pipeline.Name),
+		"queue_status":   strings.ToUpper(aws.StringValue(pipeline.Status)),
+	}
+
+	// Elastic Transcoder either uses OutputBucket for everything or separate content and thumbnail buckets.
+	destination, thumbnailDestination := aws.StringValue(pipeline.OutputBucket), aws.StringValue(pipeline.OutputBucket)
+
+	if v := pipeline.ContentConfig; v != nil {
+		if bucket := aws.StringValue(v.Bucket); bucket != "" {
+			destination = bucket
+		}
+		tfMap["storage_class"] = pipelineMediaConvertStorageClass(aws.StringValue(v.StorageClass))
+	}
+
+	if v := pipeline.ThumbnailConfig; v != nil {
+		if bucket := aws.StringValue(v.Bucket); bucket != "" {
+			thumbnailDestination = bucket
+		}
+		tfMap["thumbnail_storage_class"] = pipelineMediaConvertStorageClass(aws.StringValue(v.StorageClass))
+	}
+
+	tfMap["destination"] = pipelineS3URL(destination)
+	tfMap["thumbnail_destination"] = pipelineS3URL(thumbnailDestination)
+
+	return tfMap
+}
+
+func pipelineS3URL(bucket string) string {
+	if bucket == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("s3://%s/", bucket)
+}
+
+// pipelineMediaConvertStorageClass maps an Elastic Transcoder storage class to the MediaConvert S3 storage class.
+func pipelineMediaConvertStorageClass(storageClass string) string {
+	switch storageClass {
+	case "Standard":
+		return "STANDARD"
+	case "ReducedRedundancy":
+		return "REDUCED_REDUNDANCY"
+	default:
+		return ""
+	}
+}
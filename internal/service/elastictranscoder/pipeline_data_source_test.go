@@ -0,0 +1,52 @@
+package elastictranscoder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticTranscoderPipelineDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_elastictranscoder_pipeline.test"
+	dataSourceName := "data.aws_elastictranscoder_pipeline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "input_bucket", resourceName, "input_bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_bucket", resourceName, "output_bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "Active"),
+					resource.TestCheckResourceAttr(dataSourceName, "mediaconvert.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "mediaconvert.0.destination", fmt.Sprintf("s3://%s/", rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "mediaconvert.0.input_location", fmt.Sprintf("s3://%s/", rName)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mediaconvert.0.queue_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "mediaconvert.0.queue_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "mediaconvert.0.thumbnail_destination", fmt.Sprintf("s3://%s/", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_basic(rName), `
+data "aws_elastictranscoder_pipeline" "test" {
+  id = aws_elastictranscoder_pipeline.test.id
+}
+`)
+}
@@ -0,0 +1,245 @@
+package evidently
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFeature() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFeatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"appconfig_feature_flags": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_variation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"value_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"variations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bool_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"double_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"long_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"string_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	featureName := d.Get("name").(string)
+	projectNameOrARN := d.Get("project").(string)
+
+	feature, err := FindFeatureWithProjectNameorARN(ctx, conn, featureName, projectNameOrARN)
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Evidently Feature (%s) for Project (%s): %s", featureName, projectNameOrARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", featureName, projectNameOrARN))
+
+	appConfigFeatureFlags, err := appConfigFeatureFlagsContent(feature)
+
+	if err != nil {
+		return diag.Errorf("converting CloudWatch Evidently Feature (%s) to AppConfig feature flags: %s", featureName, err)
+	}
+
+	if err := d.Set("variations", flattenVariations(feature.Variations)); err != nil {
+		return diag.Errorf("setting variations: %s", err)
+	}
+
+	d.Set("appconfig_feature_flags", appConfigFeatureFlags)
+	d.Set("arn", feature.Arn)
+	d.Set("created_time", aws.TimeValue(feature.CreatedTime).Format(time.RFC3339))
+	d.Set("default_variation", feature.DefaultVariation)
+	d.Set("description", feature.Description)
+	d.Set("evaluation_strategy", feature.EvaluationStrategy)
+	d.Set("last_updated_time", aws.TimeValue(feature.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", feature.Name)
+	d.Set("project", feature.Project)
+	d.Set("status", feature.Status)
+	d.Set("value_type", feature.ValueType)
+
+	if err := d.Set("tags", KeyValueTags(feature.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// appConfigFeatureFlagsContent returns the feature as the content of an AppConfig feature flag configuration profile
+// (type AWS.AppConfig.FeatureFlags). The feature becomes an enabled flag, keyed by the feature name, with a "variation"
+// attribute that is constrained to the names of the feature's variations and a "value" attribute with the variation's value.
+// Both attributes are set to the feature's default variation.
+func appConfigFeatureFlagsContent(feature *cloudwatchevidently.Feature) (string, error) {
+	name := aws.StringValue(feature.Name)
+	defaultVariation := aws.StringValue(feature.DefaultVariation)
+
+	var valueType string
+	switch aws.StringValue(feature.ValueType) {
+	case cloudwatchevidently.VariationValueTypeBoolean:
+		valueType = "boolean"
+	case cloudwatchevidently.VariationValueTypeDouble, cloudwatchevidently.VariationValueTypeLong:
+		valueType = "number"
+	default:
+		valueType = "string"
+	}
+
+	var variationNames []string
+	var defaultValue interface{}
+
+	for _, v := range feature.Variations {
+		variationName := aws.StringValue(v.Name)
+		variationNames = append(variationNames, variationName)
+
+		if variationName == defaultVariation {
+			defaultValue = appConfigFeatureFlagValue(v.Value)
+		}
+	}
+
+	flag := map[string]interface{}{
+		"name": name,
+		"attributes": map[string]interface{}{
+			"value": map[string]interface{}{
+				"constraints": map[string]interface{}{
+					"type": valueType,
+				},
+			},
+			"variation": map[string]interface{}{
+				"constraints": map[string]interface{}{
+					"type": "string",
+					"enum": variationNames,
+				},
+			},
+		},
+	}
+
+	if v := aws.StringValue(feature.Description); v != "" {
+		flag["description"] = v
+	}
+
+	values := map[string]interface{}{
+		"enabled":   true,
+		"variation": defaultVariation,
+	}
+
+	if defaultValue != nil {
+		values["value"] = defaultValue
+	}
+
+	content := map[string]interface{}{
+		"flags": map[string]interface{}{
+			name: flag,
+		},
+		"values": map[string]interface{}{
+			name: values,
+		},
+		"version": "1",
+	}
+
+	b, err := json.Marshal(content)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func appConfigFeatureFlagValue(apiObject *cloudwatchevidently.VariableValue) interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// only one of these values should be set at a time
+	if v := apiObject.BoolValue; v != nil {
+		return aws.BoolValue(v)
+	} else if v := apiObject.LongValue; v != nil {
+		return aws.Int64Value(v)
+	} else if v := apiObject.DoubleValue; v != nil {
+		return aws.Float64Value(v)
+	}
+
+	return aws.StringValue(apiObject.StringValue)
+}
@@ -0,0 +1,63 @@
+package evidently_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEvidentlyFeatureDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_evidently_feature.test"
+	resourceName := "aws_evidently_feature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "appconfig_feature_flags", fmt.Sprintf(`{"flags":{%[1]q:{"attributes":{"value":{"constraints":{"type":"string"}},"variation":{"constraints":{"enum":["Variation1"],"type":"string"}}},"name":%[1]q}},"values":{%[1]q:{"enabled":true,"value":"test","variation":"Variation1"}},"version":"1"}`, rName2)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_time", resourceName, "created_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_variation", resourceName, "default_variation"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "evaluation_strategy", resourceName, "evaluation_strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "value_type", resourceName, "value_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "variations.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "variations.*", map[string]string{
+						"name":                 "Variation1",
+						"value.#":              "1",
+						"value.0.string_value": "test",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccFeatureConfig_basic(rName, rName2),
+		`
+data "aws_evidently_feature" "test" {
+  name    = aws_evidently_feature.test.name
+  project = aws_evidently_feature.test.project
+}
+`)
+}
@@ -0,0 +1,436 @@
+package m2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/m2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ExactlyOneOf:     []string{"definition.0.content", "definition.0.s3_location"},
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"s3_location": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"definition.0.content", "definition.0.s3_location"},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"engine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(m2.EngineType_Values(), false),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must start with a letter or number and contain 2 to 60 letters, numbers, underscores and hyphens"),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).M2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &m2.CreateApplicationInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Definition:  expandDefinition(d.Get("definition").([]interface{})),
+		EngineType:  aws.String(d.Get("engine_type").(string)),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Mainframe Modernization Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationId))
+
+	if _, err := waitApplicationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Mainframe Modernization Application (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).M2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	application, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Mainframe Modernization Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Mainframe Modernization Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", application.ApplicationId)
+	d.Set("arn", application.ApplicationArn)
+	d.Set("description", application.Description)
+	d.Set("engine_type", application.EngineType)
+	d.Set("kms_key_id", application.KmsKeyId)
+	d.Set("name", application.Name)
+	d.Set("status", application.Status)
+
+	version := aws.Int64Value(application.LatestVersion.ApplicationVersion)
+	d.Set("current_version", version)
+
+	// The S3 location of the definition isn't returned, so only the content is read back.
+	if v, ok := d.GetOk("definition.0.s3_location"); !ok || v.(string) == "" {
+		applicationVersion, err := FindApplicationVersionByTwoPartKey(ctx, conn, d.Id(), version)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Mainframe Modernization Application (%s) version (%d): %s", d.Id(), version, err)
+		}
+
+		if err := d.Set("definition", []interface{}{map[string]interface{}{
+			"content": aws.StringValue(applicationVersion.DefinitionContent),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
+		}
+	}
+
+	tags := KeyValueTags(application.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).M2Conn()
+
+	if d.HasChanges("definition", "description") {
+		input := &m2.UpdateApplicationInput{
+			ApplicationId:             aws.String(d.Id()),
+			CurrentApplicationVersion: aws.Int64(int64(d.Get("current_version").(int))),
+		}
+
+		if d.HasChange("definition") {
+			input.Definition = expandDefinition(d.Get("definition").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		output, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Mainframe Modernization Application (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitApplicationVersionAvailable(ctx, conn, d.Id(), aws.Int64Value(output.ApplicationVersion), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Mainframe Modernization Application (%s) version (%d) update: %s", d.Id(), aws.Int64Value(output.ApplicationVersion), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Mainframe Modernization Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).M2Conn()
+
+	log.Printf("[DEBUG] Deleting Mainframe Modernization Application: %s", d.Id())
+	_, err := conn.DeleteApplicationWithContext(ctx, &m2.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Mainframe Modernization Application (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Mainframe Modernization Application (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindApplicationByID(ctx context.Context, conn *m2.M2, id string) (*m2.GetApplicationOutput, error) {
+	input := &m2.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LatestVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindApplicationVersionByTwoPartKey(ctx context.Context, conn *m2.M2, id string, version int64) (*m2.GetApplicationVersionOutput, error) {
+	input := &m2.GetApplicationVersionInput{
+		ApplicationId:      aws.String(id),
+		ApplicationVersion: aws.Int64(version),
+	}
+
+	output, err := conn.GetApplicationVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, m2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApplication(ctx context.Context, conn *m2.M2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusApplicationVersion(ctx context.Context, conn *m2.M2, id string, version int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindApplicationVersionByTwoPartKey(ctx, conn, id, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitApplicationCreated(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleCreating},
+		Target:  []string{m2.ApplicationLifecycleAvailable, m2.ApplicationLifecycleCreated},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		if status := aws.StringValue(output.Status); status == m2.ApplicationLifecycleFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationVersionAvailable(ctx context.Context, conn *m2.M2, id string, version int64, timeout time.Duration) (*m2.GetApplicationVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationVersionLifecycleCreating},
+		Target:  []string{m2.ApplicationVersionLifecycleAvailable},
+		Refresh: statusApplicationVersion(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationVersionOutput); ok {
+		if status := aws.StringValue(output.Status); status == m2.ApplicationVersionLifecycleFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *m2.M2, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{m2.ApplicationLifecycleDeleting, m2.ApplicationLifecycleDeletingFromEnvironment},
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDefinition(tfList []interface{}) *m2.Definition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &m2.Definition{}
+
+	if v, ok := tfMap["content"].(string); ok && v != "" {
+		apiObject.Content = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_location"].(string); ok && v != "" {
+		apiObject.S3Location = aws.String(v)
+	}
+
+	return apiObject
+}
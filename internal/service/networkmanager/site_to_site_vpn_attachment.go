@@ -45,6 +45,11 @@ func ResourceSiteToSiteVPNAttachment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attachment_accepted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"attachment_policy_rule_number": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -118,10 +123,18 @@ func resourceSiteToSiteVPNAttachmentCreate(ctx context.Context, d *schema.Resour
 
 	d.SetId(aws.StringValue(output.SiteToSiteVpnAttachment.Attachment.AttachmentId))
 
-	if _, err := waitSiteToSiteVPNAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	vpnAttachment, err := waitSiteToSiteVPNAttachmentCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return diag.Errorf("waiting for Network Manager Site To Site VPN Attachment (%s) create: %s", d.Id(), err)
 	}
 
+	if d.Get("attachment_accepted").(bool) {
+		if err := acceptSiteToSiteVPNAttachment(ctx, conn, d.Id(), aws.StringValue(vpnAttachment.Attachment.State), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceSiteToSiteVPNAttachmentRead(ctx, d, meta)
 }
 
@@ -177,6 +190,12 @@ func resourceSiteToSiteVPNAttachmentRead(ctx context.Context, d *schema.Resource
 func resourceSiteToSiteVPNAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).NetworkManagerConn()
 
+	if d.HasChange("attachment_accepted") && d.Get("attachment_accepted").(bool) {
+		if err := acceptSiteToSiteVPNAttachment(ctx, conn, d.Id(), d.Get("state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -227,6 +246,28 @@ func resourceSiteToSiteVPNAttachmentDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+// acceptSiteToSiteVPNAttachment accepts the attachment if the core network requires it to be accepted
+// and waits for the attachment to become available.
+func acceptSiteToSiteVPNAttachment(ctx context.Context, conn *networkmanager.NetworkManager, id, state string, timeout time.Duration) error {
+	if state != networkmanager.AttachmentStatePendingAttachmentAcceptance && state != networkmanager.AttachmentStatePendingTagAcceptance {
+		return nil
+	}
+
+	_, err := conn.AcceptAttachmentWithContext(ctx, &networkmanager.AcceptAttachmentInput{
+		AttachmentId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("accepting Network Manager Site To Site VPN Attachment (%s): %w", id, err)
+	}
+
+	if _, err := waitSiteToSiteVPNAttachmentAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for Network Manager Site To Site VPN Attachment (%s) available: %w", id, err)
+	}
+
+	return nil
+}
+
 func FindSiteToSiteVPNAttachmentByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.SiteToSiteVpnAttachment, error) {
 	input := &networkmanager.GetSiteToSiteVpnAttachmentInput{
 		AttachmentId: aws.String(id),
@@ -271,7 +312,7 @@ func statusSiteToSiteVPNAttachmentState(ctx context.Context, conn *networkmanage
 func waitSiteToSiteVPNAttachmentCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingNetworkUpdate},
-		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingAttachmentAcceptance},
+		Target:  []string{networkmanager.AttachmentStateAvailable, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingTagAcceptance},
 		Timeout: timeout,
 		Refresh: statusSiteToSiteVPNAttachmentState(ctx, conn, id),
 	}
@@ -305,7 +346,7 @@ func waitSiteToSiteVPNAttachmentDeleted(ctx context.Context, conn *networkmanage
 
 func waitSiteToSiteVPNAttachmentAvailable(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.SiteToSiteVpnAttachment, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingNetworkUpdate},
+		Pending: []string{networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingNetworkUpdate, networkmanager.AttachmentStatePendingTagAcceptance},
 		Target:  []string{networkmanager.AttachmentStateAvailable},
 		Timeout: timeout,
 		Refresh: statusSiteToSiteVPNAttachmentState(ctx, conn, id),
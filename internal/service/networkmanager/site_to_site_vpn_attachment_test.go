@@ -142,6 +142,41 @@ func TestAccNetworkManagerSiteToSiteVPNAttachment_tags(t *testing.T) {
 	})
 }
 
+func TestAccNetworkManagerSiteToSiteVPNAttachment_attachmentAccepted(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v networkmanager.SiteToSiteVpnAttachment
+	resourceName := "aws_networkmanager_site_to_site_vpn_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bgpASN := sdkacctest.RandIntRange(64512, 65534)
+	vpnIP, err := sdkacctest.RandIpAddress("172.0.0.0/24")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSiteToSiteVPNAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteToSiteVPNAttachmentConfig_attachmentAccepted(rName, bgpASN, vpnIP),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSiteToSiteVPNAttachmentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attachment_accepted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.AttachmentStateAvailable),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attachment_accepted"},
+			},
+		},
+	})
+}
+
 func testAccCheckSiteToSiteVPNAttachmentExists(ctx context.Context, n string, v *networkmanager.SiteToSiteVpnAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -293,6 +328,20 @@ resource "aws_networkmanager_attachment_accepter" "test" {
 `)
 }
 
+func testAccSiteToSiteVPNAttachmentConfig_attachmentAccepted(rName string, bgpASN int, vpnIP string) string {
+	return acctest.ConfigCompose(testAccSiteToSiteVPNAttachmentConfig_base(rName, bgpASN, vpnIP), `
+resource "aws_networkmanager_site_to_site_vpn_attachment" "test" {
+  attachment_accepted = true
+  core_network_id     = aws_networkmanager_core_network.test.id
+  vpn_connection_arn  = aws_vpn_connection.test.arn
+
+  tags = {
+    segment = "shared"
+  }
+}
+`)
+}
+
 func testAccSiteToSiteVPNAttachmentConfig_tags1(rName, vpnIP, tagKey1, tagValue1 string, bgpASN int) string {
 	return acctest.ConfigCompose(testAccSiteToSiteVPNAttachmentConfig_base(rName, bgpASN, vpnIP), fmt.Sprintf(`
 resource "aws_networkmanager_site_to_site_vpn_attachment" "test" {
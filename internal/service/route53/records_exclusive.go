@@ -0,0 +1,334 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// recordsExclusiveChangeBatchSize is the number of changes sent in each ChangeResourceRecordSets request.
+	recordsExclusiveChangeBatchSize = 100
+)
+
+// recordsExclusiveNameValidator requires record names to be in the form returned by Route 53,
+// as the names are elements of a set and can't be normalized with a StateFunc.
+var recordsExclusiveNameValidator = validation.All(
+	validation.StringLenBetween(1, 1024),
+	validation.StringDoesNotMatch(regexp.MustCompile(`\.$`), "must not end with a period"),
+	validation.StringDoesNotMatch(regexp.MustCompile(`[A-Z]`), "must be lowercase"),
+)
+
+func ResourceRecordsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsExclusivePut,
+		ReadWithoutTimeout:   resourceRecordsExclusiveRead,
+		UpdateWithoutTimeout: resourceRecordsExclusivePut,
+		DeleteWithoutTimeout: resourceRecordsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_record_set": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     recordsExclusiveResourceRecordSetResource,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var recordsExclusiveResourceRecordSetResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"alias": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"evaluate_target_health": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: recordsExclusiveNameValidator,
+					},
+					"zone_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: recordsExclusiveNameValidator,
+		},
+		"records": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 4000),
+			},
+		},
+		"ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+		},
+	},
+}
+
+func resourceRecordsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	hostedZone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := recordsExclusiveNormalizeName(aws.StringValue(hostedZone.HostedZone.Name))
+	hash := schema.HashResource(recordsExclusiveResourceRecordSetResource)
+
+	desired := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range d.Get("resource_record_set").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name, typ := tfMap["name"].(string), tfMap["type"].(string)
+
+		if isRecordsExclusiveZoneApexRecord(name, typ, zoneName) {
+			return sdkdiag.AppendErrorf(diags, "Route 53 Hosted Zone (%s) %s record at the zone apex is managed by Route 53 and can't be included in resource_record_set", zoneID, typ)
+		}
+
+		if _, ok := desired[recordsExclusiveKey(name, typ)]; ok {
+			return sdkdiag.AppendErrorf(diags, "duplicate Route 53 Record %s %s in resource_record_set", name, typ)
+		}
+
+		desired[recordsExclusiveKey(name, typ)] = tfMap
+	}
+
+	existing, err := FindResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Records for Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	// Deletions come first so that a record set using a routing policy can be replaced by a simple one.
+	var changes []*route53.Change
+	unchanged := make(map[string]bool)
+
+	for _, apiObject := range existing {
+		name, typ := recordsExclusiveNormalizeName(aws.StringValue(apiObject.Name)), aws.StringValue(apiObject.Type)
+
+		if isRecordsExclusiveZoneApexRecord(name, typ, zoneName) {
+			continue
+		}
+
+		key := recordsExclusiveKey(name, typ)
+		tfMap, ok := desired[key]
+
+		if ok && apiObject.SetIdentifier == nil {
+			if hash(flattenRecordsExclusiveResourceRecordSet(apiObject)) == hash(tfMap) {
+				unchanged[key] = true
+			}
+
+			continue
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: apiObject,
+		})
+	}
+
+	for key, tfMap := range desired {
+		if unchanged[key] {
+			continue
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: expandRecordsExclusiveResourceRecordSet(tfMap),
+		})
+	}
+
+	for i := 0; i < len(changes); i += recordsExclusiveChangeBatchSize {
+		j := i + recordsExclusiveChangeBatchSize
+		if j > len(changes) {
+			j = len(changes)
+		}
+
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: changes[i:j],
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing Route 53 Records for Hosted Zone (%s): %d changes", zoneID, len(input.ChangeBatch.Changes))
+		outputRaw, err := ChangeRecordSet(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "changing Route 53 Records for Hosted Zone (%s): %s", zoneID, err)
+		}
+
+		if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output.ChangeInfo != nil {
+			if _, err := waitChangeInfoStatusInsync(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Records for Hosted Zone (%s) change: %s", zoneID, err)
+			}
+		}
+	}
+
+	d.SetId(zoneID)
+
+	return append(diags, resourceRecordsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRecordsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	hostedZone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Route 53 Records from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := recordsExclusiveNormalizeName(aws.StringValue(hostedZone.HostedZone.Name))
+
+	apiObjects, err := FindResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Records for Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if isRecordsExclusiveZoneApexRecord(recordsExclusiveNormalizeName(aws.StringValue(apiObject.Name)), aws.StringValue(apiObject.Type), zoneName) {
+			continue
+		}
+
+		tfList = append(tfList, flattenRecordsExclusiveResourceRecordSet(apiObject))
+	}
+
+	if err := d.Set("resource_record_set", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_set: %s", err)
+	}
+
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceRecordsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The records are left in place, only Terraform's exclusive management of the zone's records ends.
+	log.Printf("[WARN] Route 53 Records for Hosted Zone (%s) will remain, removing from state", d.Id())
+
+	return nil
+}
+
+// recordsExclusiveNormalizeName returns the record name in the form used by aws_route53_records_exclusive:
+// lowercase, with octal escapes decoded and without the trailing period.
+func recordsExclusiveNormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(CleanRecordName(name), "."))
+}
+
+func recordsExclusiveKey(name, typ string) string {
+	return fmt.Sprintf("%s %s", name, typ)
+}
+
+// isRecordsExclusiveZoneApexRecord returns whether the record is one of the zone apex NS and SOA records,
+// which can't be deleted.
+func isRecordsExclusiveZoneApexRecord(name, typ, zoneName string) bool {
+	return name == zoneName && (typ == route53.RRTypeNs || typ == route53.RRTypeSoa)
+}
+
+func expandRecordsExclusiveResourceRecordSet(tfMap map[string]interface{}) *route53.ResourceRecordSet {
+	typ := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(tfMap["name"].(string)),
+		Type: aws.String(typ),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(tfMap["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(tfMap["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(tfMap["zone_id"].(string)),
+		}
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), typ)
+	}
+
+	return apiObject
+}
+
+func flattenRecordsExclusiveResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	typ := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"alias":   []interface{}{},
+		"name":    recordsExclusiveNormalizeName(aws.StringValue(apiObject.Name)),
+		"records": flex.FlattenStringValueSet(FlattenResourceRecords(apiObject.ResourceRecords, typ)),
+		"ttl":     int(aws.Int64Value(apiObject.TTL)),
+		"type":    typ,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	return tfMap
+}
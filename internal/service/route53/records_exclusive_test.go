@@ -0,0 +1,150 @@
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveRecordCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      zoneName.Subdomain("www").String(),
+						"records.#": "1",
+						"ttl":       "300",
+						"type":      route53.RRTypeA,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      zoneName.Subdomain("txt").String(),
+						"records.#": "2",
+						"ttl":       "60",
+						"type":      route53.RRTypeTxt,
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_updated(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveRecordCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						"name":      zoneName.Subdomain("www").String(),
+						"records.#": "2",
+						"ttl":       "600",
+						"type":      route53.RRTypeA,
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRecordsExclusiveRecordCount checks the number of records in the hosted zone, excluding the zone apex NS and SOA records.
+func testAccCheckRecordsExclusiveRecordCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Hosted Zone ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+
+		output, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		got := 0
+		for _, v := range output {
+			if typ := aws.StringValue(v.Type); typ != route53.RRTypeNs && typ != route53.RRTypeSoa {
+				got++
+			}
+		}
+
+		if got != want {
+			return fmt.Errorf("Route 53 Hosted Zone (%s) has %d records, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+
+  resource_record_set {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all", "hello world"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 600
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+}
+`, zoneName)
+}
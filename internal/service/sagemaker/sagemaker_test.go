@@ -35,14 +35,14 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"space":                 testAccApp_space,
 		},
 		"Domain": {
-			"basic":                                    testAccDomain_basic,
-			"disappears":                               testAccDomain_tags,
-			"tags":                                     testAccDomain_disappears,
-			"tensorboardAppSettings":                   testAccDomain_tensorboardAppSettings,
-			"tensorboardAppSettingsWithImage":          testAccDomain_tensorboardAppSettingsWithImage,
-			"kernelGatewayAppSettings":                 testAccDomain_kernelGatewayAppSettings,
-			"kernelGatewayAppSettings_customImage":     testAccDomain_kernelGatewayAppSettings_customImage,
-			"kernelGatewayAppSettings_lifecycleConfig": testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
+			"basic":                                testAccDomain_basic,
+			"disappears":                           testAccDomain_tags,
+			"tags":                                 testAccDomain_disappears,
+			"tensorboardAppSettings":               testAccDomain_tensorboardAppSettings,
+			"tensorboardAppSettingsWithImage":      testAccDomain_tensorboardAppSettingsWithImage,
+			"kernelGatewayAppSettings":             testAccDomain_kernelGatewayAppSettings,
+			"kernelGatewayAppSettings_customImage": testAccDomain_kernelGatewayAppSettings_customImage,
+			"kernelGatewayAppSettings_lifecycleConfig":               testAccDomain_kernelGatewayAppSettings_lifecycleConfig,
 			"kernelGatewayAppSettings_defaultResourceAndCustomImage": testAccDomain_kernelGatewayAppSettings_defaultResourceSpecAndCustomImage,
 			"jupyterServerAppSettings":                               testAccDomain_jupyterServerAppSettings,
 			"kms":                                                    testAccDomain_kms,
@@ -71,6 +71,9 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"kernelGatewayAppSettings_imageConfig":     testAccSpace_kernelGatewayAppSettings_imageconfig,
 			"jupyterServerAppSettings":                 testAccSpace_jupyterServerAppSettings,
 		},
+		"StudioLifecycleConfigDomainAssociation": {
+			"basic": testAccStudioLifecycleConfigDomainAssociation_basic,
+		},
 		"UserProfile": {
 			"basic":                           testAccUserProfile_basic,
 			"disappears":                      testAccUserProfile_tags,
@@ -0,0 +1,275 @@
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStudioLifecycleConfigDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStudioLifecycleConfigDomainAssociationCreate,
+		ReadWithoutTimeout:   resourceStudioLifecycleConfigDomainAssociationRead,
+		UpdateWithoutTimeout: resourceStudioLifecycleConfigDomainAssociationUpdate,
+		DeleteWithoutTimeout: resourceStudioLifecycleConfigDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"studio_lifecycle_config_app_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.StudioLifecycleConfigAppType_Values(), false),
+			},
+			"studio_lifecycle_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceStudioLifecycleConfigDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	domainID := d.Get("domain_id").(string)
+	appType := d.Get("studio_lifecycle_config_app_type").(string)
+	id := StudioLifecycleConfigDomainAssociationCreateResourceID(domainID, appType)
+
+	if err := updateDomainDefaultStudioLifecycleConfig(ctx, conn, domainID, appType, d.Get("studio_lifecycle_config_arn").(string), ""); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Studio Lifecycle Config Domain Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceStudioLifecycleConfigDomainAssociationRead(ctx, d, meta)...)
+}
+
+func resourceStudioLifecycleConfigDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	domainID, appType, err := StudioLifecycleConfigDomainAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, err := FindDomainByName(ctx, conn, domainID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Studio Lifecycle Config Domain Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Studio Lifecycle Config Domain Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_id", domainID)
+	d.Set("studio_lifecycle_config_app_type", appType)
+	// Report the domain's current default so that any drift in DefaultUserSettings is surfaced in the plan.
+	d.Set("studio_lifecycle_config_arn", aws.StringValue(domainDefaultResourceSpec(domain.DefaultUserSettings, appType).LifecycleConfigArn))
+
+	return diags
+}
+
+func resourceStudioLifecycleConfigDomainAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	if d.HasChange("studio_lifecycle_config_arn") {
+		o, n := d.GetChange("studio_lifecycle_config_arn")
+
+		if err := updateDomainDefaultStudioLifecycleConfig(ctx, conn, d.Get("domain_id").(string), d.Get("studio_lifecycle_config_app_type").(string), n.(string), o.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Studio Lifecycle Config Domain Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStudioLifecycleConfigDomainAssociationRead(ctx, d, meta)...)
+}
+
+func resourceStudioLifecycleConfigDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	log.Printf("[DEBUG] Deleting SageMaker Studio Lifecycle Config Domain Association: %s", d.Id())
+	err := updateDomainDefaultStudioLifecycleConfig(ctx, conn, d.Get("domain_id").(string), d.Get("studio_lifecycle_config_app_type").(string), "", d.Get("studio_lifecycle_config_arn").(string))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Studio Lifecycle Config Domain Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const studioLifecycleConfigDomainAssociationResourceIDSeparator = ","
+
+func StudioLifecycleConfigDomainAssociationCreateResourceID(domainID, appType string) string {
+	parts := []string{domainID, appType}
+	id := strings.Join(parts, studioLifecycleConfigDomainAssociationResourceIDSeparator)
+
+	return id
+}
+
+func StudioLifecycleConfigDomainAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, studioLifecycleConfigDomainAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-ID%[2]sAPP-TYPE", id, studioLifecycleConfigDomainAssociationResourceIDSeparator)
+}
+
+// updateDomainDefaultStudioLifecycleConfig sets (or, if lifecycleConfigARN is empty, clears) the default
+// Studio Lifecycle Configuration used for new apps of the specified type in the specified domain.
+// Any previous default, previousLifecycleConfigARN, is also removed from the app's available configurations.
+func updateDomainDefaultStudioLifecycleConfig(ctx context.Context, conn *sagemaker.SageMaker, domainID, appType, lifecycleConfigARN, previousLifecycleConfigARN string) error {
+	domain, err := FindDomainByName(ctx, conn, domainID)
+
+	if err != nil {
+		return err
+	}
+
+	current := domain.DefaultUserSettings
+	if current == nil {
+		current = &sagemaker.UserSettings{}
+	}
+
+	// Preserve the rest of the default resource specification.
+	resourceSpec := domainDefaultResourceSpec(current, appType)
+	if lifecycleConfigARN == "" {
+		resourceSpec.LifecycleConfigArn = nil
+	} else {
+		resourceSpec.LifecycleConfigArn = aws.String(lifecycleConfigARN)
+	}
+
+	userSettings := &sagemaker.UserSettings{}
+
+	switch appType {
+	case sagemaker.StudioLifecycleConfigAppTypeJupyterServer:
+		appSettings := &sagemaker.JupyterServerAppSettings{}
+		if current.JupyterServerAppSettings != nil {
+			appSettings.CodeRepositories = current.JupyterServerAppSettings.CodeRepositories
+			appSettings.LifecycleConfigArns = current.JupyterServerAppSettings.LifecycleConfigArns
+		}
+		appSettings.DefaultResourceSpec = resourceSpec
+		appSettings.LifecycleConfigArns = appendLifecycleConfigARN(removeLifecycleConfigARN(appSettings.LifecycleConfigArns, previousLifecycleConfigARN), lifecycleConfigARN)
+		userSettings.JupyterServerAppSettings = appSettings
+	case sagemaker.StudioLifecycleConfigAppTypeKernelGateway:
+		appSettings := &sagemaker.KernelGatewayAppSettings{}
+		if current.KernelGatewayAppSettings != nil {
+			appSettings.CustomImages = current.KernelGatewayAppSettings.CustomImages
+			appSettings.LifecycleConfigArns = current.KernelGatewayAppSettings.LifecycleConfigArns
+		}
+		appSettings.DefaultResourceSpec = resourceSpec
+		appSettings.LifecycleConfigArns = appendLifecycleConfigARN(removeLifecycleConfigARN(appSettings.LifecycleConfigArns, previousLifecycleConfigARN), lifecycleConfigARN)
+		userSettings.KernelGatewayAppSettings = appSettings
+	default:
+		return fmt.Errorf("unsupported Studio Lifecycle Config app type: %s", appType)
+	}
+
+	input := &sagemaker.UpdateDomainInput{
+		DefaultUserSettings: userSettings,
+		DomainId:            aws.String(domainID),
+	}
+
+	log.Printf("[DEBUG] Updating SageMaker Domain (%s) default user settings: %s", domainID, input)
+	if _, err := conn.UpdateDomainWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := WaitDomainInService(ctx, conn, domainID); err != nil {
+		return fmt.Errorf("waiting for SageMaker Domain (%s) update: %w", domainID, err)
+	}
+
+	return nil
+}
+
+// domainDefaultResourceSpec returns a copy of the default resource specification for the specified app type.
+func domainDefaultResourceSpec(userSettings *sagemaker.UserSettings, appType string) *sagemaker.ResourceSpec {
+	var resourceSpec *sagemaker.ResourceSpec
+
+	if userSettings != nil {
+		switch appType {
+		case sagemaker.StudioLifecycleConfigAppTypeJupyterServer:
+			if userSettings.JupyterServerAppSettings != nil {
+				resourceSpec = userSettings.JupyterServerAppSettings.DefaultResourceSpec
+			}
+		case sagemaker.StudioLifecycleConfigAppTypeKernelGateway:
+			if userSettings.KernelGatewayAppSettings != nil {
+				resourceSpec = userSettings.KernelGatewayAppSettings.DefaultResourceSpec
+			}
+		}
+	}
+
+	if resourceSpec == nil {
+		return &sagemaker.ResourceSpec{}
+	}
+
+	return &sagemaker.ResourceSpec{
+		InstanceType:             resourceSpec.InstanceType,
+		LifecycleConfigArn:       resourceSpec.LifecycleConfigArn,
+		SageMakerImageArn:        resourceSpec.SageMakerImageArn,
+		SageMakerImageVersionArn: resourceSpec.SageMakerImageVersionArn,
+	}
+}
+
+// appendLifecycleConfigARN ensures that the default lifecycle configuration is also one of the app's available configurations.
+func appendLifecycleConfigARN(arns []*string, arn string) []*string {
+	if arn == "" {
+		return arns
+	}
+
+	for _, v := range arns {
+		if aws.StringValue(v) == arn {
+			return arns
+		}
+	}
+
+	return append(arns, aws.String(arn))
+}
+
+// removeLifecycleConfigARN removes a lifecycle configuration from the app's available configurations.
+// An empty, rather than nil, list is returned when the last one is removed so that UpdateDomain clears the list.
+func removeLifecycleConfigARN(arns []*string, arn string) []*string {
+	if arn == "" {
+		return arns
+	}
+
+	result := make([]*string, 0, len(arns))
+
+	for _, v := range arns {
+		if aws.StringValue(v) != arn {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
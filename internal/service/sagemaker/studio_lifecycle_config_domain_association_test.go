@@ -0,0 +1,197 @@
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+)
+
+func testAccStudioLifecycleConfigDomainAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_studio_lifecycle_config_domain_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioLifecycleConfigDomainAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioLifecycleConfigDomainAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_sagemaker_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "studio_lifecycle_config_app_type", "JupyterServer"),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_lifecycle_config_arn", "aws_sagemaker_studio_lifecycle_config.test1", "arn"),
+					testAccCheckDomainJupyterServerLifecycleConfigARNs(ctx, "aws_sagemaker_domain.test", "aws_sagemaker_studio_lifecycle_config.test1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStudioLifecycleConfigDomainAssociationConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioLifecycleConfigDomainAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_lifecycle_config_arn", "aws_sagemaker_studio_lifecycle_config.test2", "arn"),
+					testAccCheckDomainJupyterServerLifecycleConfigARNs(ctx, "aws_sagemaker_domain.test", "aws_sagemaker_studio_lifecycle_config.test2"),
+				),
+			},
+			{
+				Config: testAccStudioLifecycleConfigDomainAssociationConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainJupyterServerLifecycleConfigARNs(ctx, "aws_sagemaker_domain.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStudioLifecycleConfigDomainAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Studio Lifecycle Config Domain Association ID is set")
+		}
+
+		domainID, appType, err := tfsagemaker.StudioLifecycleConfigDomainAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		domain, err := tfsagemaker.FindDomainByName(ctx, conn, domainID)
+
+		if err != nil {
+			return err
+		}
+
+		var resourceSpec *sagemaker.ResourceSpec
+
+		switch appType {
+		case sagemaker.StudioLifecycleConfigAppTypeJupyterServer:
+			if v := domain.DefaultUserSettings.JupyterServerAppSettings; v != nil {
+				resourceSpec = v.DefaultResourceSpec
+			}
+		case sagemaker.StudioLifecycleConfigAppTypeKernelGateway:
+			if v := domain.DefaultUserSettings.KernelGatewayAppSettings; v != nil {
+				resourceSpec = v.DefaultResourceSpec
+			}
+		}
+
+		if resourceSpec == nil || aws.StringValue(resourceSpec.LifecycleConfigArn) != rs.Primary.Attributes["studio_lifecycle_config_arn"] {
+			return fmt.Errorf("SageMaker Domain (%s) default %s Studio Lifecycle Config is not %s", domainID, appType, rs.Primary.Attributes["studio_lifecycle_config_arn"])
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDomainJupyterServerLifecycleConfigARNs checks that the domain's default JupyterServer app settings
+// make exactly the specified Studio Lifecycle Configs available.
+func testAccCheckDomainJupyterServerLifecycleConfigARNs(ctx context.Context, n string, lifecycleConfigResourceNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var want []string
+		for _, v := range lifecycleConfigResourceNames {
+			lrs, ok := s.RootModule().Resources[v]
+			if !ok {
+				return fmt.Errorf("Not found: %s", v)
+			}
+
+			want = append(want, lrs.Primary.Attributes["arn"])
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		domain, err := tfsagemaker.FindDomainByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		if v := domain.DefaultUserSettings.JupyterServerAppSettings; v != nil {
+			got = aws.StringValueSlice(v.LifecycleConfigArns)
+		}
+
+		if len(got) != len(want) {
+			return fmt.Errorf("SageMaker Domain (%s) JupyterServer Studio Lifecycle Configs are %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		for i := range want {
+			if got[i] != want[i] {
+				return fmt.Errorf("SageMaker Domain (%s) JupyterServer Studio Lifecycle Configs are %v, want %v", rs.Primary.ID, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStudioLifecycleConfigDomainAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_studio_lifecycle_config" "test1" {
+  studio_lifecycle_config_name     = "%[1]s-1"
+  studio_lifecycle_config_app_type = "JupyterServer"
+  studio_lifecycle_config_content  = base64encode("echo Hello")
+}
+
+resource "aws_sagemaker_studio_lifecycle_config" "test2" {
+  studio_lifecycle_config_name     = "%[1]s-2"
+  studio_lifecycle_config_app_type = "JupyterServer"
+  studio_lifecycle_config_content  = base64encode("echo World")
+}
+
+resource "aws_sagemaker_domain" "test" {
+  domain_name = %[1]q
+  auth_mode   = "IAM"
+  vpc_id      = aws_vpc.test.id
+  subnet_ids  = aws_subnet.test[*].id
+
+  default_user_settings {
+    execution_role = aws_iam_role.test.arn
+  }
+
+  retention_policy {
+    home_efs_file_system = "Delete"
+  }
+
+  lifecycle {
+    ignore_changes = [default_user_settings[0].jupyter_server_app_settings]
+  }
+}
+`, rName))
+}
+
+func testAccStudioLifecycleConfigDomainAssociationConfig_basic(rName, lifecycleConfig string) string {
+	return acctest.ConfigCompose(testAccStudioLifecycleConfigDomainAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_studio_lifecycle_config_domain_association" "test" {
+  domain_id                        = aws_sagemaker_domain.test.id
+  studio_lifecycle_config_app_type = "JupyterServer"
+  studio_lifecycle_config_arn      = aws_sagemaker_studio_lifecycle_config.%[1]s.arn
+}
+`, lifecycleConfig))
+}
@@ -1,6 +1,10 @@
 package servicecatalog
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -16,3 +20,59 @@ func suppressEquivalentJSONEmptyNilDiffs(k, old, new string, d *schema.ResourceD
 
 	return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
 }
+
+// suppressEquivalentTemplateURLDiffs suppresses differences in surrounding whitespace,
+// host name case, S3 URL style and request signature query parameters between template URLs.
+func suppressEquivalentTemplateURLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeTemplateURL(old) == normalizeTemplateURL(new)
+}
+
+func normalizeTemplateURL(v string) string {
+	v = strings.TrimSpace(v)
+
+	u, err := url.Parse(v)
+
+	if err != nil {
+		return v
+	}
+
+	u.Host = strings.ToLower(u.Host)
+
+	// Virtual-hosted and path-style URLs for the same S3 object, with or without a Region, are equivalent.
+	if m := s3EndpointHostRegexp.FindStringSubmatch(u.Host); m != nil {
+		bucket, key := m[1], strings.TrimPrefix(u.Path, "/")
+
+		if bucket == "" {
+			bucket, key, _ = strings.Cut(key, "/")
+		}
+
+		u.Scheme = "s3"
+		u.Host = bucket
+		u.Path = "/" + key
+		u.RawPath = ""
+	}
+
+	query := u.Query()
+	for k := range query {
+		if isTemplateURLSignatureQueryParameter(k) {
+			query.Del(k)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// s3EndpointHostRegexp matches S3 endpoint host names, capturing the bucket of virtual-hosted-style host names.
+var s3EndpointHostRegexp = regexp.MustCompile(`^(?:(.+)\.)?s3(?:[.-](?:dualstack\.)?[a-z0-9-]+)?\.amazonaws\.com(?:\.cn)?$`)
+
+func isTemplateURLSignatureQueryParameter(k string) bool {
+	switch k := strings.ToLower(k); k {
+	// Signature Version 2.
+	case "awsaccesskeyid", "expires", "signature", "x-amz-security-token":
+		return true
+	default:
+		// Signature Version 4.
+		return strings.HasPrefix(k, "x-amz-")
+	}
+}
@@ -12,6 +12,10 @@ const (
 	ConstraintTypeResourceUpdate = "RESOURCE_UPDATE"
 	ConstraintTypeStackset       = "STACKSET"
 	ConstraintTypeTemplate       = "TEMPLATE"
+
+	TemplateValidationModeDefault = "default"
+	TemplateValidationModeNone    = "none"
+	TemplateValidationModeStrict  = "strict"
 )
 
 func AcceptLanguage_Values() []string {
@@ -31,3 +35,11 @@ func ConstraintType_Values() []string {
 		ConstraintTypeTemplate,
 	}
 }
+
+func TemplateValidationMode_Values() []string {
+	return []string{
+		TemplateValidationModeDefault,
+		TemplateValidationModeNone,
+		TemplateValidationModeStrict,
+	}
+}
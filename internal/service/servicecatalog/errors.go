@@ -0,0 +1,12 @@
+package servicecatalog
+
+import (
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+// isTemplateCapabilitiesError returns whether Service Catalog rejected a template because it requires capabilities.
+// The error doesn't reliably list them, so use ValidateTemplate's Capabilities to report which ones.
+func isTemplateCapabilitiesError(err error) bool {
+	return tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "capabilities")
+}
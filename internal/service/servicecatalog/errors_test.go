@@ -0,0 +1,45 @@
+package servicecatalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+func TestIsTemplateCapabilitiesError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		err  error
+		want bool
+	}{
+		{
+			err:  nil,
+			want: false,
+		},
+		{
+			err:  errors.New("requires capabilities : [CAPABILITY_IAM]"),
+			want: false,
+		},
+		{
+			err:  awserr.New(servicecatalog.ErrCodeInvalidParametersException, "Invalid templateUrl", nil),
+			want: false,
+		},
+		{
+			err:  awserr.New(servicecatalog.ErrCodeResourceNotFoundException, "Requires capabilities : [CAPABILITY_IAM]", nil),
+			want: false,
+		},
+		{
+			err:  awserr.New(servicecatalog.ErrCodeInvalidParametersException, "Requires capabilities : [CAPABILITY_IAM]", nil),
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := isTemplateCapabilitiesError(testCase.err); got != testCase.want {
+			t.Errorf("isTemplateCapabilitiesError(%v) = %t, want %t", testCase.err, got, testCase.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package servicecatalog
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -179,3 +180,129 @@ func FindPrincipalPortfolioAssociation(ctx context.Context, conn *servicecatalog
 
 	return result, err
 }
+
+// FindProductByID uses DescribeProductAsAdmin as, unlike DescribeProduct, it also finds products
+// that are not in a portfolio the caller has access to.
+func FindProductByID(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, productID string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	input := &servicecatalog.DescribeProductAsAdminInput{
+		Id: aws.String(productID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.DescribeProductAsAdminWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProductViewDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindProvisioningArtifactByTwoPartKey(ctx context.Context, conn *servicecatalog.ServiceCatalog, artifactID, productID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	output, err := conn.DescribeProvisioningArtifactWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindProvisioningArtifactsByProductID(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, productID string) ([]*servicecatalog.ProvisioningArtifactDetail, error) {
+	input := &servicecatalog.ListProvisioningArtifactsInput{
+		ProductId: aws.String(productID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.ListProvisioningArtifactsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// ListProvisioningArtifacts doesn't accept a page token, so further pages can't be requested.
+	// Fail rather than silently return a truncated list.
+	if aws.StringValue(output.NextPageToken) != "" {
+		return nil, fmt.Errorf("results are truncated (next page token %q) and ListProvisioningArtifacts does not support pagination", aws.StringValue(output.NextPageToken))
+	}
+
+	return output.ProvisioningArtifactDetails, nil
+}
+
+func FindProvisionedProductsByProvisioningArtifactID(ctx context.Context, conn *servicecatalog.ServiceCatalog, artifactID string) ([]*servicecatalog.ProvisionedProductAttribute, error) {
+	input := &servicecatalog.SearchProvisionedProductsInput{
+		AccessLevelFilter: &servicecatalog.AccessLevelFilter{
+			Key:   aws.String(servicecatalog.AccessLevelFilterKeyAccount),
+			Value: aws.String("self"), // only supported value
+		},
+		Filters: map[string][]*string{
+			servicecatalog.ProvisionedProductViewFilterBySearchQuery: aws.StringSlice([]string{fmt.Sprintf("provisioningArtifactId:%s", artifactID)}),
+		},
+	}
+
+	var result []*servicecatalog.ProvisionedProductAttribute
+
+	err := conn.SearchProvisionedProductsPagesWithContext(ctx, input, func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProvisionedProducts {
+			if v != nil && aws.StringValue(v.ProvisioningArtifactId) == artifactID {
+				result = append(result, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package servicecatalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+)
+
+func TestFindProvisioningArtifactsByProductID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		pages     []*servicecatalog.ListProvisioningArtifactsOutput
+		expectErr bool
+		expected  int
+	}{
+		{
+			name: "single page",
+			pages: []*servicecatalog.ListProvisioningArtifactsOutput{
+				{
+					ProvisioningArtifactDetails: []*servicecatalog.ProvisioningArtifactDetail{
+						{Id: aws.String("pa-1")},
+						{Id: aws.String("pa-2")},
+					},
+				},
+			},
+			expected: 2,
+		},
+		{
+			name: "more than one page",
+			pages: []*servicecatalog.ListProvisioningArtifactsOutput{
+				{
+					NextPageToken: aws.String("token"),
+					ProvisioningArtifactDetails: []*servicecatalog.ProvisioningArtifactDetail{
+						{Id: aws.String("pa-1")},
+					},
+				},
+				{
+					ProvisioningArtifactDetails: []*servicecatalog.ProvisioningArtifactDetail{
+						{Id: aws.String("pa-2")},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := servicecatalog.New(sess)
+			conn.Handlers.Clear()
+
+			page := 0
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				*r.Data.(*servicecatalog.ListProvisioningArtifactsOutput) = *testCase.pages[page]
+				page++
+			})
+
+			artifacts, err := tfservicecatalog.FindProvisioningArtifactsByProductID(context.Background(), conn, tfservicecatalog.AcceptLanguageEnglish, "prod-123")
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := len(artifacts); got != testCase.expected {
+				t.Errorf("got %d provisioning artifacts, expected %d", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package servicecatalog
 import (
 	"fmt"
 	"strings"
+
+	"golang.org/x/exp/slices"
 )
 
 func PortfolioShareParseResourceID(id string) (string, string, string, error) {
@@ -74,6 +76,34 @@ func ProvisioningArtifactParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// ProvisioningArtifactParseImportID parses an artifactID:productID or productID/name import ID, optionally followed
+// by :acceptLanguage. Exactly one of the returned artifactID and name is set. A provisioning artifact name can contain
+// any character, so only the first slash and the last colon are separators, the latter only if it's followed by a
+// known accept_language.
+func ProvisioningArtifactParseImportID(id string) (artifactID, productID, name, acceptLanguage string, err error) {
+	rest, acceptLanguage := id, AcceptLanguageEnglish
+
+	if i := strings.LastIndex(rest, ":"); i != -1 && slices.Contains(AcceptLanguage_Values(), rest[i+1:]) {
+		rest, acceptLanguage = rest[:i], rest[i+1:]
+	}
+
+	if productID, name, ok := strings.Cut(rest, "/"); ok {
+		if productID == "" || name == "" {
+			return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected productID/name, optionally followed by :acceptLanguage", id)
+		}
+
+		return "", productID, name, acceptLanguage, nil
+	}
+
+	parts := strings.Split(rest, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected artifactID:productID or productID/name, optionally followed by :acceptLanguage", id)
+	}
+
+	return parts[0], parts[1], "", acceptLanguage, nil
+}
+
 func PrincipalPortfolioAssociationParseID(id string) (string, string, string, error) {
 	parts := strings.SplitN(id, ",", 3)
 
@@ -0,0 +1,126 @@
+package servicecatalog_test
+
+import (
+	"testing"
+
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+)
+
+func TestProvisioningArtifactParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName               string
+		InputID                string
+		ExpectError            bool
+		ExpectedArtifactID     string
+		ExpectedProductID      string
+		ExpectedName           string
+		ExpectedAcceptLanguage string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "artifact ID only",
+			InputID:     "pa-ij2b6lusy6dec",
+			ExpectError: true,
+		},
+		{
+			TestName:               "artifact and product IDs",
+			InputID:                "pa-ij2b6lusy6dec:prod-el3an0rma3",
+			ExpectedArtifactID:     "pa-ij2b6lusy6dec",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageEnglish,
+		},
+		{
+			TestName:               "artifact and product IDs with accept_language",
+			InputID:                "pa-ij2b6lusy6dec:prod-el3an0rma3:jp",
+			ExpectedArtifactID:     "pa-ij2b6lusy6dec",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageJapanese,
+		},
+		{
+			TestName:    "artifact and product IDs with unknown accept_language",
+			InputID:     "pa-ij2b6lusy6dec:prod-el3an0rma3:fr",
+			ExpectError: true,
+		},
+		{
+			TestName:               "product ID and name",
+			InputID:                "prod-el3an0rma3/v1",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedName:           "v1",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageEnglish,
+		},
+		{
+			TestName:               "product ID and name with accept_language",
+			InputID:                "prod-el3an0rma3/v1:zh",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedName:           "v1",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageChinese,
+		},
+		{
+			TestName:               "name with slashes and colons",
+			InputID:                "prod-el3an0rma3/release/2023:01:v1",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedName:           "release/2023:01:v1",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageEnglish,
+		},
+		{
+			TestName:               "name ending in an accept_language",
+			InputID:                "prod-el3an0rma3/v1:jp:en",
+			ExpectedProductID:      "prod-el3an0rma3",
+			ExpectedName:           "v1:jp",
+			ExpectedAcceptLanguage: tfservicecatalog.AcceptLanguageEnglish,
+		},
+		{
+			TestName:    "empty name",
+			InputID:     "prod-el3an0rma3/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty product ID",
+			InputID:     "/v1",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			artifactID, productID, name, acceptLanguage, err := tfservicecatalog.ProvisioningArtifactParseImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if artifactID != testCase.ExpectedArtifactID {
+				t.Errorf("got artifact ID %q, expected %q", artifactID, testCase.ExpectedArtifactID)
+			}
+
+			if productID != testCase.ExpectedProductID {
+				t.Errorf("got product ID %q, expected %q", productID, testCase.ExpectedProductID)
+			}
+
+			if name != testCase.ExpectedName {
+				t.Errorf("got name %q, expected %q", name, testCase.ExpectedName)
+			}
+
+			if acceptLanguage != testCase.ExpectedAcceptLanguage {
+				t.Errorf("got accept_language %q, expected %q", acceptLanguage, testCase.ExpectedAcceptLanguage)
+			}
+		})
+	}
+}
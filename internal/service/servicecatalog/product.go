@@ -355,6 +355,8 @@ func resourceProductUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Service Catalog Product (%s): %s", d.Id(), err)
 		}
+
+		provisioningArtifactProductCache.invalidate(d.Id())
 	}
 
 	if d.HasChange("tags_all") {
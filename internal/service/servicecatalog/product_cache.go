@@ -0,0 +1,87 @@
+package servicecatalog
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// productCache shares product lookups between the reads of provisioning artifacts of the same product,
+// so that a product with many managed provisioning artifacts is only described once.
+// The provider process only lives for a single Terraform operation, so entries are scoped to that operation.
+type productCache struct {
+	mu      sync.Mutex
+	entries map[productCacheKey]*productCacheEntry
+}
+
+type productCacheKey struct {
+	acceptLanguage string
+	productID      string
+	region         string
+}
+
+type productCacheEntry struct {
+	mu     sync.Mutex
+	output *servicecatalog.DescribeProductAsAdminOutput
+}
+
+var provisioningArtifactProductCache = &productCache{}
+
+// get returns the cached product for key, calling find if it is not cached yet.
+// Concurrent callers for the same key wait for the first lookup. Errors are not cached.
+func (c *productCache) get(key productCacheKey, find func() (*servicecatalog.DescribeProductAsAdminOutput, error)) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[productCacheKey]*productCacheEntry)
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &productCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.output != nil {
+		return entry.output, nil
+	}
+
+	output, err := find()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry.output = output
+
+	return output, nil
+}
+
+// invalidate removes the cached product in all regions and languages, e.g. after the product has been updated.
+func (c *productCache) invalidate(productID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if k.productID == productID {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// findProductByIDCached is FindProductByID, sharing the result between provisioning artifacts of the same product.
+func findProductByIDCached(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, productID string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	key := productCacheKey{
+		acceptLanguage: acceptLanguage,
+		productID:      productID,
+		region:         aws.StringValue(conn.Config.Region),
+	}
+
+	return provisioningArtifactProductCache.get(key, func() (*servicecatalog.DescribeProductAsAdminOutput, error) {
+		return FindProductByID(ctx, conn, acceptLanguage, productID)
+	})
+}
@@ -0,0 +1,93 @@
+package servicecatalog
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+func TestProductCache(t *testing.T) {
+	t.Parallel()
+
+	c := &productCache{}
+	key := productCacheKey{acceptLanguage: "en", productID: "prod-abcdefghijklm", region: "us-west-2"} // lintignore:AWSAT003
+	calls := 0
+	find := func() (*servicecatalog.DescribeProductAsAdminOutput, error) {
+		calls++
+		return &servicecatalog.DescribeProductAsAdminOutput{
+			ProductViewDetail: &servicecatalog.ProductViewDetail{
+				ProductViewSummary: &servicecatalog.ProductViewSummary{Owner: aws.String("owner")},
+			},
+		}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			output, err := c.get(key, find)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			if got, want := aws.StringValue(output.ProductViewDetail.ProductViewSummary.Owner), "owner"; got != want {
+				t.Errorf("got owner %q, want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d lookups, want 1", calls)
+	}
+
+	otherKey := key
+	otherKey.acceptLanguage = "jp"
+
+	if _, err := c.get(otherKey, find); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d lookups after a lookup in another language, want 2", calls)
+	}
+
+	c.invalidate(key.productID)
+
+	if _, err := c.get(key, find); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("got %d lookups after invalidation, want 3", calls)
+	}
+}
+
+func TestProductCache_errorNotCached(t *testing.T) {
+	t.Parallel()
+
+	c := &productCache{}
+	key := productCacheKey{acceptLanguage: "en", productID: "prod-abcdefghijklm", region: "us-west-2"} // lintignore:AWSAT003
+	calls := 0
+	find := func() (*servicecatalog.DescribeProductAsAdminOutput, error) {
+		calls++
+		return nil, errors.New("throttled")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.get(key, find); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d lookups, want 2", calls)
+	}
+}
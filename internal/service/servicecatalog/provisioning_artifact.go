@@ -2,12 +2,22 @@ package servicecatalog
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 func ResourceProvisioningArtifact() *schema.Resource {
@@ -24,7 +36,7 @@ func ResourceProvisioningArtifact() *schema.Resource {
 		UpdateWithoutTimeout: resourceProvisioningArtifactUpdate,
 		DeleteWithoutTimeout: resourceProvisioningArtifactDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceProvisioningArtifactImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -34,6 +46,8 @@ func ResourceProvisioningArtifact() *schema.Resource {
 			Delete: schema.DefaultTimeout(ProvisioningArtifactDeleteTimeout),
 		},
 
+		CustomizeDiff: resourceProvisioningArtifactCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"accept_language": {
 				Type:         schema.TypeString,
@@ -46,6 +60,11 @@ func ResourceProvisioningArtifact() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"allow_duplicate_names": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"created_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,7 +78,20 @@ func ResourceProvisioningArtifact() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fetch_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"guidance": {
 				Type:         schema.TypeString,
@@ -76,6 +108,38 @@ func ResourceProvisioningArtifact() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"product_owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"retain_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_content_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"template_physical_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -85,41 +149,251 @@ func ResourceProvisioningArtifact() *schema.Resource {
 					"template_physical_id",
 				},
 			},
+			"template_validation_mode": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(TemplateValidationMode_Values(), false),
+				ConflictsWith: []string{"disable_template_validation"},
+			},
 			"template_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentTemplateURLDiffs,
 				ExactlyOneOf: []string{
 					"template_url",
 					"template_physical_id",
 				},
 			},
+			"track_template_content": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(servicecatalog.ProvisioningArtifactType_Values(), false),
 			},
+			"validate_launch": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"validate_product_id": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+// resourceProvisioningArtifactImport accepts either the canonical artifactID:productID ID or productID/name,
+// which is resolved to the ID of the product's provisioning artifact with that name.
+// Either form can be followed by the accept_language, e.g. artifactID:productID:jp or productID/name/jp.
+func resourceProvisioningArtifactImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	artifactID, productID, name, acceptLanguage, err := ProvisioningArtifactParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("accept_language", acceptLanguage)
+
+	if artifactID != "" {
+		d.SetId(ProvisioningArtifactID(artifactID, productID))
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	conn, err := provisioningArtifactConn(d, meta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := FindProvisioningArtifactsByProductID(ctx, conn, acceptLanguage, productID)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing Service Catalog Provisioning Artifacts for product (%s): %w", productID, err)
+	}
+
+	var artifactIDs []string
+
+	for _, artifact := range artifacts {
+		if artifact != nil && aws.StringValue(artifact.Name) == name {
+			artifactIDs = append(artifactIDs, aws.StringValue(artifact.Id))
+		}
+	}
+
+	switch len(artifactIDs) {
+	case 0:
+		return nil, fmt.Errorf("Service Catalog Product (%s) has no provisioning artifact named %q", productID, name)
+	case 1:
+	default:
+		return nil, fmt.Errorf("Service Catalog Product (%s) has %d provisioning artifacts named %q (%s), import using artifactID:productID", productID, len(artifactIDs), name, strings.Join(artifactIDs, ", "))
+	}
+
+	d.SetId(ProvisioningArtifactID(artifactIDs[0], productID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceProvisioningArtifactCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// description is Optional+Computed, so an explicit empty string would otherwise produce no diff.
+	if v := d.GetRawConfig().GetAttr("description"); v.IsKnown() && !v.IsNull() && v.AsString() == "" && d.Get("description").(string) != "" {
+		if err := d.SetNew("description", ""); err != nil {
+			return err
+		}
+	}
+
+	// Template validation only happens on creation, so changing disable_template_validation requires a new artifact.
+	// Re-enabling validation on an artifact that is already AVAILABLE is a no-op though, as its template was accepted.
+	if d.HasChange("disable_template_validation") {
+		if o, n := d.GetChange("disable_template_validation"); o.(bool) && !n.(bool) && d.Get("status").(string) == servicecatalog.StatusAvailable {
+			log.Printf("[DEBUG] Service Catalog Provisioning Artifact (%s) is %s, not replacing it to re-enable template validation", d.Id(), servicecatalog.StatusAvailable)
+		} else {
+			log.Printf("[INFO] Service Catalog Provisioning Artifact (%s) templates are only validated on creation, replacing it to change disable_template_validation", d.Id())
+
+			if err := d.ForceNew("disable_template_validation"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("track_template_content") {
+		if err := d.SetNewComputed("template_content_hash"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandUpdateProvisioningArtifactInput returns the UpdateProvisioningArtifact input for the changed mutable attributes only,
+// so that e.g. toggling active doesn't re-assert the name and description from state over changes made outside of Terraform.
+func expandUpdateProvisioningArtifactInput(d *schema.ResourceData, artifactID, productID string) *servicecatalog.UpdateProvisioningArtifactInput {
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if v := d.Get("accept_language").(string); v != "" {
+		input.AcceptLanguage = aws.String(v)
+	}
+
+	// Active is not a field of CreateProvisioningArtifact, so it's always set for a new artifact.
+	if d.HasChange("active") || d.IsNewResource() {
+		input.Active = aws.Bool(d.Get("active").(bool))
+	}
+
+	// HasChange covers description being explicitly cleared, see resourceProvisioningArtifactCustomizeDiff.
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if v := d.Get("guidance").(string); v != "" && d.HasChange("guidance") {
+		input.Guidance = aws.String(v)
+	}
+
+	if v := d.Get("name").(string); v != "" && d.HasChange("name") {
+		input.Name = aws.String(v)
+	}
+
+	return input
+}
+
 func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+	conn, err := provisioningArtifactConn(d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	productID := d.Get("product_id").(string)
+
+	// An unknown product otherwise only surfaces as an unclear error from CreateProvisioningArtifact.
+	// A product created in the same apply may also still be propagating, so wait for it to be ready.
+	if d.Get("validate_product_id").(bool) {
+		acceptLanguage := d.Get("accept_language").(string)
+		_, err := WaitProductReady(ctx, conn, acceptLanguage, productID, d.Timeout(schema.TimeoutCreate))
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: product (%s) not found, or not accessible with accept_language %q", productID, acceptLanguage)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: waiting for product (%s) to be ready: %s", productID, err)
+		}
+	}
+
+	disableTemplateValidation := d.Get("disable_template_validation").(bool)
+
+	switch d.Get("template_validation_mode").(string) {
+	case TemplateValidationModeNone:
+		disableTemplateValidation = true
+	case TemplateValidationModeStrict:
+		// The template arguments force a new provisioning artifact, so validating on creation covers every template.
+		templateURL := strings.TrimSpace(d.Get("template_url").(string))
+		templatePhysicalID := d.Get("template_physical_id").(string)
+
+		cfnConn, err := provisioningArtifactCloudFormationConn(d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		diags = append(diags, validateProvisioningArtifactTemplate(ctx, cfnConn, templateURL, templatePhysicalID)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Operations on the provisioning artifacts of a product can fail with InvalidStateException
+	// when they run concurrently, so serialize them for each product only.
+	mutexKey := provisioningArtifactMutexKey(productID)
+	if err := conns.GlobalMutexKV.LockWithContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: timed out waiting for product lock (%s): %s", mutexKey, err)
+	}
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// Catch a name collision before anything is created rather than failing part way through an apply.
+	// This runs under the product lock so that artifacts being created concurrently for the product are seen.
+	if name := d.Get("name").(string); name != "" && !d.Get("allow_duplicate_names").(bool) {
+		artifacts, err := FindProvisioningArtifactsByProductID(ctx, conn, d.Get("accept_language").(string), productID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Service Catalog Provisioning Artifacts for product (%s): %s", productID, err)
+		}
+
+		for _, artifact := range artifacts {
+			if aws.StringValue(artifact.Name) == name {
+				return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: product (%s) already has a provisioning artifact (%s) named %q, set allow_duplicate_names to create it anyway", productID, aws.StringValue(artifact.Id), name)
+			}
+		}
+	}
 
 	parameters := make(map[string]interface{})
 	parameters["description"] = d.Get("description")
-	parameters["disable_template_validation"] = d.Get("disable_template_validation")
+	parameters["disable_template_validation"] = disableTemplateValidation
 	parameters["name"] = d.Get("name")
 	parameters["template_physical_id"] = d.Get("template_physical_id")
-	parameters["template_url"] = d.Get("template_url")
+	parameters["template_url"] = strings.TrimSpace(d.Get("template_url").(string))
 	parameters["type"] = d.Get("type")
 
 	input := &servicecatalog.CreateProvisioningArtifactInput{
 		IdempotencyToken: aws.String(resource.UniqueId()),
 		Parameters:       expandProvisioningArtifactParameters(parameters),
-		ProductId:        aws.String(d.Get("product_id").(string)),
+		ProductId:        aws.String(productID),
 	}
 
 	if v, ok := d.GetOk("accept_language"); ok {
@@ -127,7 +401,8 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	var output *servicecatalog.CreateProvisioningArtifactOutput
-	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	start := time.Now()
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		output, err = conn.CreateProvisioningArtifactWithContext(ctx, input)
@@ -136,6 +411,11 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 			return resource.RetryableError(err)
 		}
 
+		// A product created in the same apply may still be propagating.
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) && time.Since(start) < ProductReadyTimeout {
+			return resource.RetryableError(err)
+		}
+
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -147,6 +427,13 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 		output, err = conn.CreateProvisioningArtifactWithContext(ctx, input)
 	}
 
+	// Service Catalog doesn't say which capabilities a template requires, so ask CloudFormation.
+	if isTemplateCapabilitiesError(err) {
+		if capabilities := findProvisioningArtifactTemplateCapabilities(ctx, d, meta); len(capabilities) > 0 {
+			return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: template validation failed, the template requires capabilities %s: %s", strings.Join(capabilities, ", "), err)
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: %s", err)
 	}
@@ -155,17 +442,74 @@ func resourceProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact: empty response")
 	}
 
-	d.SetId(ProvisioningArtifactID(aws.StringValue(output.ProvisioningArtifactDetail.Id), d.Get("product_id").(string)))
+	d.SetId(ProvisioningArtifactID(aws.StringValue(output.ProvisioningArtifactDetail.Id), productID))
 
 	// Active and Guidance are not fields of CreateProvisioningArtifact but are fields of UpdateProvisioningArtifact.
 	// In order to set these to non-default values, you must create and then update.
+	active := d.Get("active").(bool)
+
+	artifactID := aws.StringValue(output.ProvisioningArtifactDetail.Id)
+	diags = append(diags, updateProvisioningArtifact(ctx, d, meta)...)
 
-	return append(diags, resourceProvisioningArtifactUpdate(ctx, d, meta)...)
+	if diags.HasError() {
+		return rollbackProvisioningArtifactCreate(ctx, d, conn, artifactID, productID, diags)
+	}
+
+	if d.Get("failure_reason").(string) != "" {
+		return diags
+	}
+
+	// The update can succeed without the activation taking effect (e.g. when throttled), so check what was read back.
+	if d.Get("active").(bool) != active {
+		if err := updateProvisioningArtifactActive(ctx, conn, d.Get("accept_language").(string), artifactID, productID, active, d.Timeout(schema.TimeoutCreate)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+
+			return rollbackProvisioningArtifactCreate(ctx, d, conn, artifactID, productID, diags)
+		}
+
+		diags = append(diags, resourceProvisioningArtifactRead(ctx, d, meta)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Inactive provisioning artifacts are invisible to end users, so they can't be launched.
+	if d.Get("validate_launch").(bool) && active {
+		diags = append(diags, validateProvisioningArtifactLaunch(ctx, conn, d.Get("accept_language").(string), artifactID, productID)...)
+
+		if diags.HasError() {
+			return rollbackProvisioningArtifactCreate(ctx, d, conn, artifactID, productID, diags)
+		}
+	}
+
+	return diags
+}
+
+// rollbackProvisioningArtifactCreate deletes a provisioning artifact whose creation failed part way through so that
+// it isn't left behind with default settings, unless retain_on_failure is set. The caller must hold the product lock.
+func rollbackProvisioningArtifactCreate(ctx context.Context, d *schema.ResourceData, conn *servicecatalog.ServiceCatalog, artifactID, productID string, diags diag.Diagnostics) diag.Diagnostics {
+	if d.Get("retain_on_failure").(bool) {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact (%s) after failed create", d.Id())
+	if err := deleteProvisioningArtifact(ctx, conn, d.Get("accept_language").(string), artifactID, productID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Provisioning Artifact (%s) after failed create: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return diags
 }
 
 func resourceProvisioningArtifactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+	conn, err := provisioningArtifactConn(d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
 	artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
 
@@ -181,6 +525,14 @@ func resourceProvisioningArtifactRead(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
+	// A FAILED artifact can optionally be kept in state so that it can be inspected.
+	if err != nil && d.Get("retain_on_failure").(bool) && output != nil && aws.StringValue(output.Status) == servicecatalog.StatusFailed {
+		d.Set("failure_reason", provisioningArtifactFailureReason(output))
+		err = nil
+	} else {
+		d.Set("failure_reason", "")
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
 	}
@@ -189,11 +541,43 @@ func resourceProvisioningArtifactRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "getting Service Catalog Provisioning Artifact (%s): empty response", d.Id())
 	}
 
+	return append(diags, resourceProvisioningArtifactFlatten(ctx, d, conn, output, artifactID, productID)...)
+}
+
+// resourceProvisioningArtifactReadNoWait reads the provisioning artifact with a single DescribeProvisioningArtifact call.
+// It is used after updates that cannot change the artifact's status, where waiting for it to be ready is unnecessary.
+func resourceProvisioningArtifactReadNoWait(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := provisioningArtifactConn(d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindProvisioningArtifactByTwoPartKey(ctx, conn, artifactID, productID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProvisioningArtifactFlatten(ctx, d, conn, output, artifactID, productID)...)
+}
+
+func resourceProvisioningArtifactFlatten(ctx context.Context, d *schema.ResourceData, conn *servicecatalog.ServiceCatalog, output *servicecatalog.DescribeProvisioningArtifactOutput, artifactID, productID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	if v, ok := output.Info["ImportFromPhysicalId"]; ok {
 		d.Set("template_physical_id", v)
 	}
 
-	if v, ok := output.Info["LoadTemplateFromURL"]; ok {
+	// Keep the configured form of an equivalent template URL so that a canonicalized URL doesn't show as changed.
+	if v, ok := output.Info["LoadTemplateFromURL"]; ok && normalizeTemplateURL(aws.StringValue(v)) != normalizeTemplateURL(d.Get("template_url").(string)) {
 		d.Set("template_url", v)
 	}
 
@@ -207,44 +591,96 @@ func resourceProvisioningArtifactRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("guidance", pad.Guidance)
 	d.Set("name", pad.Name)
 	d.Set("product_id", productID)
+	d.Set("region", conn.Config.Region)
+	d.Set("status", output.Status)
 	d.Set("type", pad.Type)
 
+	// The product owner is only informational, so don't fail the read if the product can't be described as an administrator.
+	product, err := findProductByIDCached(ctx, conn, d.Get("accept_language").(string), productID)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Service Catalog Product (%s) not accessible, not setting product_owner: %s", productID, err)
+		d.Set("product_owner", "")
+	} else if err != nil {
+		// e.g. throttling that persists beyond the SDK's retries. Keep the product_owner value already in state.
+		diags = sdkdiag.AppendWarningf(diags, "reading Service Catalog Product (%s), not refreshing product_owner: %s", productID, err)
+	} else if v := product.ProductViewDetail.ProductViewSummary; v != nil {
+		d.Set("product_owner", v.Owner)
+	}
+
+	// The template can be large, so it is only downloaded when asked for.
+	fetchTemplate, trackTemplateContent := d.Get("fetch_template").(bool), d.Get("track_template_content").(bool)
+
+	var hash string
+	if fetchTemplate || trackTemplateContent {
+		body, err := findProvisioningArtifactTemplateBody(ctx, conn, d.Get("accept_language").(string), artifactID, productID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Service Catalog Provisioning Artifact (%s) template: %s", d.Id(), err)
+		}
+
+		sum := sha256.Sum256([]byte(body))
+		hash = hex.EncodeToString(sum[:])
+
+		if fetchTemplate {
+			d.Set("template_body", body)
+		}
+	}
+
+	if fetchTemplate {
+		d.Set("template_hash", hash)
+	} else {
+		d.Set("template_body", "")
+		d.Set("template_hash", "")
+	}
+
+	// Unlike template_hash, template_content_hash can be tracked without storing the template in state.
+	if trackTemplateContent {
+		d.Set("template_content_hash", hash)
+	} else {
+		d.Set("template_content_hash", "")
+	}
+
 	return diags
 }
 
 func resourceProvisioningArtifactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
-	if d.HasChanges("accept_language", "active", "description", "guidance", "name", "product_id") {
-		artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
+	_, productID, err := ProvisioningArtifactParseID(d.Id())
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", d.Id(), err)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", d.Id(), err)
+	}
 
-		input := &servicecatalog.UpdateProvisioningArtifactInput{
-			ProductId:              aws.String(productID),
-			ProvisioningArtifactId: aws.String(artifactID),
-			Active:                 aws.Bool(d.Get("active").(bool)),
-		}
+	mutexKey := provisioningArtifactMutexKey(productID)
+	if err := conns.GlobalMutexKV.LockWithContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Provisioning Artifact (%s): timed out waiting for product lock (%s): %s", d.Id(), mutexKey, err)
+	}
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-		if v, ok := d.GetOk("accept_language"); ok {
-			input.AcceptLanguage = aws.String(v.(string))
-		}
+	return updateProvisioningArtifact(ctx, d, meta)
+}
 
-		if v, ok := d.GetOk("description"); ok {
-			input.Description = aws.String(v.(string))
-		}
+// updateProvisioningArtifact updates the provisioning artifact and reads it back.
+// The caller must hold the product's provisioning artifact lock.
+func updateProvisioningArtifact(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, err := provisioningArtifactConn(d, meta)
 
-		if v, ok := d.GetOk("guidance"); ok {
-			input.Guidance = aws.String(v.(string))
-		}
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
-		if v, ok := d.GetOk("name"); ok {
-			input.Name = aws.String(v.(string))
+	if d.HasChanges("accept_language", "active", "description", "guidance", "name", "product_id") {
+		artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", d.Id(), err)
 		}
 
+		input := expandUpdateProvisioningArtifactInput(d, artifactID, productID)
+
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, err := conn.UpdateProvisioningArtifactWithContext(ctx, input)
 
@@ -264,16 +700,41 @@ func resourceProvisioningArtifactUpdate(ctx context.Context, d *schema.ResourceD
 		}
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+			if !d.Get("retain_on_failure").(bool) || !provisioningArtifactFailed(ctx, conn, artifactID, productID) {
+				return sdkdiag.AppendErrorf(diags, "updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+			}
+
+			diags = sdkdiag.AppendWarningf(diags, "updating FAILED Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
 		}
 	}
 
+	if !provisioningArtifactUpdateAffectsStatus(d) && !diags.HasError() {
+		return append(diags, resourceProvisioningArtifactReadNoWait(ctx, d, meta)...)
+	}
+
 	return append(diags, resourceProvisioningArtifactRead(ctx, d, meta)...)
 }
 
+// provisioningArtifactStatusIndependentAttributes are the attributes whose changes don't affect the provisioning artifact's status.
+var provisioningArtifactStatusIndependentAttributes = []string{
+	"active",
+	"disable_template_validation",
+	"guidance",
+}
+
+// provisioningArtifactUpdateAffectsStatus returns whether the update can change the provisioning artifact's status.
+// Changing only guidance, active or disable_template_validation doesn't, so the ready waiter can be skipped.
+func provisioningArtifactUpdateAffectsStatus(d *schema.ResourceData) bool {
+	return d.HasChangesExcept(provisioningArtifactStatusIndependentAttributes...)
+}
+
 func resourceProvisioningArtifactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+	conn, err := provisioningArtifactConn(d, meta)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
 	artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
 
@@ -281,27 +742,396 @@ func resourceProvisioningArtifactDelete(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", d.Id(), err)
 	}
 
+	if d.Get("force_destroy").(bool) {
+		if err := retireProvisioningArtifact(ctx, conn, d.Get("accept_language").(string), artifactID, productID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+				return diags
+			}
+
+			return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Retirement can wait for a long time, so the product lock is only taken for the deletion itself.
+	mutexKey := provisioningArtifactMutexKey(productID)
+	if err := conns.GlobalMutexKV.LockWithContext(ctx, mutexKey); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Provisioning Artifact (%s): timed out waiting for product lock (%s): %s", d.Id(), mutexKey, err)
+	}
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	if err := deleteProvisioningArtifact(ctx, conn, d.Get("accept_language").(string), artifactID, productID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteProvisioningArtifact deletes the provisioning artifact and waits for it to be gone.
+// The caller must hold the product lock.
+func deleteProvisioningArtifact(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, artifactID, productID string, timeout time.Duration) error {
 	input := &servicecatalog.DeleteProvisioningArtifactInput{
 		ProductId:              aws.String(productID),
 		ProvisioningArtifactId: aws.String(artifactID),
 	}
 
-	if v, ok := d.GetOk("accept_language"); ok {
-		input.AcceptLanguage = aws.String(v.(string))
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
 	}
 
-	_, err = conn.DeleteProvisioningArtifactWithContext(ctx, input)
+	_, err := conn.DeleteProvisioningArtifactWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := WaitProvisioningArtifactDeleted(ctx, conn, artifactID, productID, timeout); err != nil {
+		return fmt.Errorf("waiting for deletion: %w", err)
+	}
+
+	return nil
+}
+
+// provisioningArtifactConn returns a Service Catalog connection for the provisioning artifact's region,
+// which overrides the provider's region when set.
+func provisioningArtifactConn(d *schema.ResourceData, meta interface{}) (*servicecatalog.ServiceCatalog, error) {
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+
+	sess, err := provisioningArtifactSessionForRegion(d, meta, &conn.Config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sess == nil {
+		return conn, nil
+	}
+
+	return servicecatalog.New(sess), nil
+}
+
+// provisioningArtifactCloudFormationConn returns a CloudFormation connection for the provisioning artifact's region,
+// so that e.g. the stack of template_physical_id is looked up where the provisioning artifact is created.
+func provisioningArtifactCloudFormationConn(d *schema.ResourceData, meta interface{}) (*cloudformation.CloudFormation, error) {
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
+
+	sess, err := provisioningArtifactSessionForRegion(d, meta, &conn.Config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sess == nil {
+		return conn, nil
+	}
+
+	return cloudformation.New(sess), nil
+}
+
+// provisioningArtifactSessionForRegion returns a session, based on the provider client configuration cfg, for the
+// provisioning artifact's region. It returns nil if the region isn't overridden.
+func provisioningArtifactSessionForRegion(d *schema.ResourceData, meta interface{}, cfg *aws.Config) (*session.Session, error) {
+	region := d.Get("region").(string)
+
+	if region == "" || region == aws.StringValue(cfg.Region) {
+		return nil, nil
+	}
+
+	sess, err := conns.NewSessionForRegion(cfg, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating AWS session for region (%s): %w", region, err)
+	}
+
+	return sess, nil
+}
+
+func provisioningArtifactMutexKey(productID string) string {
+	return fmt.Sprintf("aws_servicecatalog_provisioning_artifact-%s", productID)
+}
+
+// retireProvisioningArtifact deprecates and deactivates the provisioning artifact so that it can no longer be used
+// to launch or update provisioned products, then waits for existing provisioned products to be moved off it.
+func retireProvisioningArtifact(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, artifactID, productID string, timeout time.Duration) error {
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		Active:                 aws.Bool(false),
+		Guidance:               aws.String(servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	if _, err := conn.UpdateProvisioningArtifactWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	_, err := WaitProvisioningArtifactNotInUse(ctx, conn, artifactID, timeout)
+
+	if tfresource.TimedOut(err) {
+		if remaining, findErr := FindProvisionedProductsByProvisioningArtifactID(ctx, conn, artifactID); findErr == nil && len(remaining) > 0 {
+			ids := make([]string, 0, len(remaining))
+			for _, v := range remaining {
+				ids = append(ids, aws.StringValue(v.Id))
+			}
+
+			return fmt.Errorf("still in use after %s by %d provisioned product(s): %s", timeout, len(remaining), strings.Join(ids, ", "))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("waiting for provisioned products to stop using it: %w", err)
+	}
+
+	return nil
+}
+
+// updateProvisioningArtifactActive repeats the activation update until the provisioning artifact reports the expected Active value.
+func updateProvisioningArtifactActive(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, artifactID, productID string, active bool, timeout time.Duration) error {
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		Active:                 aws.Bool(active),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		output, err := FindProvisioningArtifactByTwoPartKey(ctx, conn, artifactID, productID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if aws.BoolValue(output.ProvisioningArtifactDetail.Active) == active {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact (%s) active: %t", artifactID, active)
+		_, err = conn.UpdateProvisioningArtifactWithContext(ctx, input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, "ThrottlingException") && !tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
+			return resource.NonRetryableError(err)
+		}
+
+		return resource.RetryableError(fmt.Errorf("waiting for active to be %t", active))
+	})
+
+	if tfresource.TimedOut(err) {
+		return fmt.Errorf("provisioning artifact active is not %t", active)
+	}
+
+	return err
+}
+
+func provisioningArtifactFailed(ctx context.Context, conn *servicecatalog.ServiceCatalog, artifactID, productID string) bool {
+	output, err := FindProvisioningArtifactByTwoPartKey(ctx, conn, artifactID, productID)
+
+	if err != nil {
+		return false
+	}
+
+	return aws.StringValue(output.Status) == servicecatalog.StatusFailed
+}
+
+// provisioningArtifactTemplateSourceInfoKeys are the DescribeProvisioningArtifact Info keys that describe where
+// the template came from rather than the state of the provisioning artifact.
+var provisioningArtifactTemplateSourceInfoKeys = []string{"ImportFromPhysicalId", "LoadTemplateFromURL", "TemplateUrl"}
+
+// provisioningArtifactFailureReason returns the reason that DescribeProvisioningArtifact reports for a FAILED
+// provisioning artifact. The response has no dedicated status message, so any details that Service Catalog
+// returns in Info (other than the template source) are used, falling back to the status itself.
+func provisioningArtifactFailureReason(output *servicecatalog.DescribeProvisioningArtifactOutput) string {
+	var details []string
+
+	for k, v := range output.Info {
+		if slices.Contains(provisioningArtifactTemplateSourceInfoKeys, k) || aws.StringValue(v) == "" {
+			continue
+		}
+
+		details = append(details, fmt.Sprintf("%s: %s", k, aws.StringValue(v)))
+	}
+
+	if len(details) == 0 {
+		return fmt.Sprintf("provisioning artifact status is %s", aws.StringValue(output.Status))
+	}
+
+	sort.Strings(details)
+
+	return strings.Join(details, "; ")
+}
+
+// findProvisioningArtifactTemplateBody downloads the template that Service Catalog stores for the provisioning artifact.
+// A verbose DescribeProvisioningArtifact returns a pre-signed URL for the template whichever source it was created from.
+func findProvisioningArtifactTemplateBody(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, artifactID, productID string) (string, error) {
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		Verbose:                aws.Bool(true),
+	}
+
+	if acceptLanguage != "" {
+		input.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	output, err := conn.DescribeProvisioningArtifactWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	url := aws.StringValue(output.Info["TemplateUrl"])
+
+	if url == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := cleanhttp.DefaultClient().Do(request)
+
+	if err != nil {
+		return "", fmt.Errorf("downloading template: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading template: unexpected HTTP status: %s", response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("reading template: %w", err)
+	}
+
+	return string(body), nil
+}
+
+// validateProvisioningArtifactTemplate validates the template with CloudFormation's ValidateTemplate API in addition to
+// the validation done by Service Catalog. A template that CloudFormation rejects is an error; findings that Service
+// Catalog does not report are warnings.
+func validateProvisioningArtifactTemplate(ctx context.Context, conn *cloudformation.CloudFormation, templateURL, templatePhysicalID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := validateCloudFormationTemplate(ctx, conn, templateURL, templatePhysicalID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating Service Catalog Provisioning Artifact template: %s", err)
+	}
+
+	return append(diags, templateValidationFindings(output)...)
+}
+
+// templateValidationFindings returns a warning for each ValidateTemplate finding worth a second look:
+// the capabilities that the launch role must be allowed to use, and NoEcho parameters with a default value,
+// which is stored in plain text in the template.
+func templateValidationFindings(output *cloudformation.ValidateTemplateOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if output == nil {
 		return diags
 	}
 
+	if len(output.Capabilities) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "Service Catalog Provisioning Artifact template requires capabilities %s, which the launch role must be allowed to use: %s", strings.Join(aws.StringValueSlice(output.Capabilities), ", "), aws.StringValue(output.CapabilitiesReason))
+	}
+
+	for _, v := range output.Parameters {
+		if aws.BoolValue(v.NoEcho) && v.DefaultValue != nil {
+			diags = sdkdiag.AppendWarningf(diags, "Service Catalog Provisioning Artifact template parameter (%s) is NoEcho but has a default value, which is stored in plain text in the template", aws.StringValue(v.ParameterKey))
+		}
+	}
+
+	return diags
+}
+
+// validateCloudFormationTemplate calls CloudFormation's ValidateTemplate API for the template at templateURL,
+// or for the template of the templatePhysicalID stack. A nil output is returned if neither is set.
+func validateCloudFormationTemplate(ctx context.Context, conn *cloudformation.CloudFormation, templateURL, templatePhysicalID string) (*cloudformation.ValidateTemplateOutput, error) {
+	input := &cloudformation.ValidateTemplateInput{}
+
+	switch {
+	case templateURL != "":
+		input.TemplateURL = aws.String(templateURL)
+	case templatePhysicalID != "":
+		output, err := conn.GetTemplateWithContext(ctx, &cloudformation.GetTemplateInput{
+			StackName: aws.String(templatePhysicalID),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading CloudFormation Stack (%s) template: %w", templatePhysicalID, err)
+		}
+
+		input.TemplateBody = output.TemplateBody
+	default:
+		return nil, nil
+	}
+
+	return conn.ValidateTemplateWithContext(ctx, input)
+}
+
+// findProvisioningArtifactTemplateCapabilities returns the capabilities that CloudFormation reports the provisioning
+// artifact's template requires. It is only used to explain a failure, so any error is logged and ignored.
+func findProvisioningArtifactTemplateCapabilities(ctx context.Context, d *schema.ResourceData, meta interface{}) []string {
+	conn, err := provisioningArtifactCloudFormationConn(d, meta)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+		log.Printf("[WARN] Service Catalog Provisioning Artifact template capabilities not checked: %s", err)
+		return nil
+	}
+
+	output, err := validateCloudFormationTemplate(ctx, conn, strings.TrimSpace(d.Get("template_url").(string)), d.Get("template_physical_id").(string))
+
+	if err != nil {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact template capabilities not checked: %s", err)
+		return nil
+	}
+
+	if output == nil {
+		return nil
+	}
+
+	return aws.StringValueSlice(output.Capabilities)
+}
+
+// validateProvisioningArtifactLaunch checks that the provisioning artifact can be launched using the product's default launch path,
+// and that the default value of each of its parameters satisfies the parameter's allowed values.
+func validateProvisioningArtifactLaunch(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, artifactID, productID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &servicecatalog.DescribeProvisioningParametersInput{
+		AcceptLanguage:         aws.String(acceptLanguage),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
 	}
 
-	if err := WaitProvisioningArtifactDeleted(ctx, conn, artifactID, productID, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Provisioning Artifact (%s) to be deleted: %s", d.Id(), err)
+	output, err := conn.DescribeProvisioningParametersWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioning Artifact (%s) provisioning parameters: %s", artifactID, err)
+	}
+
+	for _, v := range output.ProvisioningArtifactParameters {
+		if v == nil || v.DefaultValue == nil || v.ParameterConstraints == nil || len(v.ParameterConstraints.AllowedValues) == 0 {
+			continue
+		}
+
+		if allowedValues := aws.StringValueSlice(v.ParameterConstraints.AllowedValues); !slices.Contains(allowedValues, aws.StringValue(v.DefaultValue)) {
+			diags = sdkdiag.AppendErrorf(diags, "Service Catalog Provisioning Artifact (%s) parameter (%s) default value %q is not one of the allowed values %q", artifactID, aws.StringValue(v.ParameterKey), aws.StringValue(v.DefaultValue), allowedValues)
+		}
 	}
 
 	return diags
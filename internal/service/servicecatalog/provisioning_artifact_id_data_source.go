@@ -0,0 +1,47 @@
+package servicecatalog
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceProvisioningArtifactID() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProvisioningArtifactIDRead,
+
+		Schema: map[string]*schema.Schema{
+			"artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProvisioningArtifactIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id := d.Get("id").(string)
+	artifactID, productID, err := ProvisioningArtifactParseID(id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing Service Catalog Provisioning Artifact ID (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("artifact_id", artifactID)
+	d.Set("product_id", productID)
+
+	return diags
+}
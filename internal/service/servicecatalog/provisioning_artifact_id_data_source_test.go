@@ -0,0 +1,53 @@
+package servicecatalog_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceCatalogProvisioningArtifactIDDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_servicecatalog_provisioning_artifact_id.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactIDDataSourceConfig_basic("pa-4abcdjnxjj6ne:prod-4v6rc4hwaiiiw"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "artifact_id", "pa-4abcdjnxjj6ne"),
+					resource.TestCheckResourceAttr(dataSourceName, "id", "pa-4abcdjnxjj6ne:prod-4v6rc4hwaiiiw"),
+					resource.TestCheckResourceAttr(dataSourceName, "product_id", "prod-4v6rc4hwaiiiw"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifactIDDataSource_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningArtifactIDDataSourceConfig_basic("pa-4abcdjnxjj6ne"),
+				ExpectError: regexp.MustCompile(`expected artifactID:productID`),
+			},
+		},
+	})
+}
+
+func testAccProvisioningArtifactIDDataSourceConfig_basic(id string) string {
+	return fmt.Sprintf(`
+data "aws_servicecatalog_provisioning_artifact_id" "test" {
+  id = %[1]q
+}
+`, id)
+}
@@ -3,6 +3,7 @@ package servicecatalog_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,6 +43,9 @@ func TestAccServiceCatalogProvisioningArtifact_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDefault),
 					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-2", rName)),
 					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_owner", "aws_servicecatalog_product.test", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
 					resource.TestCheckResourceAttrSet(resourceName, "template_url"),
 					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProductTypeCloudFormationTemplate),
 					acctest.CheckResourceAttrRFC3339(resourceName, "created_time"),
@@ -52,9 +56,46 @@ func TestAccServiceCatalogProvisioningArtifact_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"accept_language",
+					"allow_duplicate_names",
+					"disable_template_validation",
+					"fetch_template",
+					"force_destroy",
+					"retain_on_failure",
+					"template_url",
+					"template_validation_mode",
+					"validate_product_id",
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccProvisioningArtifactNameImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"allow_duplicate_names",
+					"disable_template_validation",
+					"fetch_template",
+					"force_destroy",
+					"retain_on_failure",
+					"template_url",
+					"template_validation_mode",
+					"validate_product_id",
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccProvisioningArtifactAcceptLanguageImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"allow_duplicate_names",
 					"disable_template_validation",
+					"fetch_template",
+					"force_destroy",
+					"retain_on_failure",
 					"template_url",
+					"template_validation_mode",
+					"validate_product_id",
 				},
 			},
 		},
@@ -126,14 +167,194 @@ func TestAccServiceCatalogProvisioningArtifact_update(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
+					"allow_duplicate_names",
 					"disable_template_validation",
+					"fetch_template",
+					"force_destroy",
+					"retain_on_failure",
 					"template_url",
+					"template_validation_mode",
+					"validate_product_id",
 				},
 			},
 		},
 	})
 }
 
+func TestAccServiceCatalogProvisioningArtifact_templateValidationModeStrict(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_templateValidationMode(rName, domain, tfservicecatalog.TemplateValidationModeStrict),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_validation_mode", tfservicecatalog.TemplateValidationModeStrict),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_templateValidationModeStrictInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningArtifactConfig_templateValidationModeStrictInvalid(rName, domain),
+				ExpectError: regexp.MustCompile(`validating Service Catalog Provisioning Artifact template: .*Unresolved resource dependencies`),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_sameProduct(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_sameProduct(rName, domain, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, "aws_servicecatalog_provisioning_artifact.test.0"),
+					testAccCheckProvisioningArtifactExists(ctx, "aws_servicecatalog_provisioning_artifact.test.1"),
+					testAccCheckProvisioningArtifactExists(ctx, "aws_servicecatalog_provisioning_artifact.test.2"),
+					testAccCheckProvisioningArtifactExists(ctx, "aws_servicecatalog_provisioning_artifact.test.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_guidance(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_guidance(rName, domain, servicecatalog.ProvisioningArtifactGuidanceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDefault),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_guidance(rName, domain, servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-2", rName)),
+				),
+			},
+			{
+				// Deprecated provisioning artifacts can be restored to the default guidance.
+				Config: testAccProvisioningArtifactConfig_guidance(rName, domain, servicecatalog.ProvisioningArtifactGuidanceDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDefault),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_clearDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_description(rName, domain, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_description(rName, domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_enableTemplateValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+	var createdTime string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_disableTemplateValidation(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disable_template_validation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					func(s *terraform.State) error {
+						createdTime = s.RootModule().Resources[resourceName].Primary.Attributes["created_time"]
+						return nil
+					},
+				),
+			},
+			{
+				// The artifact is AVAILABLE, so re-enabling validation doesn't replace it.
+				Config: testAccProvisioningArtifactConfig_disableTemplateValidation(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disable_template_validation", "false"),
+					resource.TestCheckResourceAttrPtr(resourceName, "created_time", &createdTime),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisioningArtifact_physicalID(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioning_artifact.test"
@@ -169,14 +390,148 @@ func TestAccServiceCatalogProvisioningArtifact_physicalID(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
+					"allow_duplicate_names",
 					"disable_template_validation",
+					"fetch_template",
+					"force_destroy",
+					"retain_on_failure",
 					"template_physical_id",
+					"validate_product_id",
 				},
 			},
 		},
 	})
 }
 
+func TestAccServiceCatalogProvisioningArtifact_fetchTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_fetchTemplate(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fetch_template", "true"),
+					resource.TestMatchResourceAttr(resourceName, "template_body", regexp.MustCompile(`AWS::EC2::VPC`)),
+					resource.TestMatchResourceAttr(resourceName, "template_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_fetchTemplate(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fetch_template", "false"),
+					resource.TestCheckResourceAttr(resourceName, "template_body", ""),
+					resource.TestCheckResourceAttr(resourceName, "template_hash", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_validateLaunch(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_validateLaunch(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "validate_launch", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_trackTemplateContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactConfig_trackTemplateContent(rName, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_body", ""),
+					resource.TestMatchResourceAttr(resourceName, "template_content_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "track_template_content", "true"),
+				),
+			},
+			{
+				Config: testAccProvisioningArtifactConfig_trackTemplateContent(rName, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisioningArtifactExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_content_hash", ""),
+					resource.TestCheckResourceAttr(resourceName, "track_template_content", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningArtifactConfig_duplicateName(rName, domain),
+				ExpectError: regexp.MustCompile(`already has a provisioning artifact .* named`),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogProvisioningArtifact_productNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningArtifactConfig_productNotFound(rName, domain),
+				ExpectError: regexp.MustCompile(`product \(prod-[0-9a-z]+\) not found`),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningArtifactDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn()
@@ -247,6 +602,28 @@ func testAccCheckProvisioningArtifactExists(ctx context.Context, resourceName st
 	}
 }
 
+func testAccProvisioningArtifactNameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["product_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccProvisioningArtifactAcceptLanguageImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, rs.Primary.Attributes["accept_language"]), nil
+	}
+}
+
 func testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -343,6 +720,110 @@ resource "aws_servicecatalog_provisioning_artifact" "test" {
 `, rName))
 }
 
+func testAccProvisioningArtifactConfig_templateValidationMode(rName, domain, mode string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  name                     = "%[1]s-2"
+  product_id               = aws_servicecatalog_product.test.id
+  template_url             = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  template_validation_mode = %[2]q
+  type                     = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, mode))
+}
+
+func testAccProvisioningArtifactConfig_templateValidationModeStrictInvalid(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_s3_object" "invalid" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s-invalid.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.1.0.0/16"
+        }
+      }
+    }
+
+    Outputs = {
+      VpcID = {
+        Value = {
+          Ref = "MissingVPC"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  name                     = "%[1]s-2"
+  product_id               = aws_servicecatalog_product.test.id
+  template_url             = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.invalid.key}"
+  template_validation_mode = "strict"
+  type                     = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName))
+}
+
+func testAccProvisioningArtifactConfig_sameProduct(rName, domain string, count int) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  count = %[2]d
+
+  disable_template_validation = true
+  guidance                    = "DEPRECATED"
+  name                        = "%[1]s-${count.index}"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, count))
+}
+
+func testAccProvisioningArtifactConfig_guidance(rName, domain, guidance string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  description                 = %[1]q
+  disable_template_validation = true
+  guidance                    = %[2]q
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, guidance))
+}
+
+func testAccProvisioningArtifactConfig_description(rName, domain, description string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  description                 = %[2]q
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, description))
+}
+
+func testAccProvisioningArtifactConfig_disableTemplateValidation(rName, domain string, disableTemplateValidation bool) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = %[2]t
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, disableTemplateValidation))
+}
+
 func testAccProvisioningArtifactPhysicalIDBaseConfig(rName, domain string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "test" {
@@ -406,3 +887,87 @@ resource "aws_servicecatalog_provisioning_artifact" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningArtifactConfig_validateLaunch(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = data.aws_iam_session_context.current.issuer_arn # launch paths are only returned for the caller
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = aws_servicecatalog_principal_portfolio_association.test.portfolio_id # avoid depends_on
+  product_id   = aws_servicecatalog_product.test.id
+}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product_portfolio_association.test.product_id # avoid depends_on
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+  validate_launch             = true
+}
+`, rName))
+}
+
+func testAccProvisioningArtifactConfig_duplicateName(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = %[1]q
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName))
+}
+
+func testAccProvisioningArtifactConfig_productNotFound(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = "prod-000000000000a"
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName))
+}
+
+func testAccProvisioningArtifactConfig_fetchTemplate(rName, domain string, fetchTemplate bool) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  fetch_template              = %[2]t
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, fetchTemplate))
+}
+
+func testAccProvisioningArtifactConfig_trackTemplateContent(rName, domain string, trackTemplateContent bool) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactTemplateURLBaseConfig(rName, domain), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  track_template_content      = %[2]t
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+`, rName, trackTemplateContent))
+}
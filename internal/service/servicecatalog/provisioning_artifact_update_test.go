@@ -0,0 +1,326 @@
+package servicecatalog
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestExpandUpdateProvisioningArtifactInput(t *testing.T) {
+	t.Parallel()
+
+	// The resource data is built from an empty state, so every attribute whose configured or default value
+	// differs from its zero value is changed. For example, active is only changed when it's true.
+	testCases := map[string]struct {
+		raw             map[string]interface{}
+		isNewResource   bool
+		wantActive      *bool
+		wantDescription *string
+		wantGuidance    *string
+		wantName        *string
+	}{
+		"active unchanged": {
+			raw:          map[string]interface{}{"active": false},
+			wantGuidance: aws.String(servicecatalog.ProvisioningArtifactGuidanceDefault),
+		},
+		"description": {
+			raw:             map[string]interface{}{"description": "description"},
+			wantActive:      aws.Bool(true),
+			wantDescription: aws.String("description"),
+			wantGuidance:    aws.String(servicecatalog.ProvisioningArtifactGuidanceDefault),
+		},
+		"guidance and name": {
+			raw:          map[string]interface{}{"guidance": servicecatalog.ProvisioningArtifactGuidanceDeprecated, "name": "name"},
+			wantActive:   aws.Bool(true),
+			wantGuidance: aws.String(servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+			wantName:     aws.String("name"),
+		},
+		"new resource": {
+			raw:             map[string]interface{}{"active": false, "description": "description", "name": "name"},
+			isNewResource:   true,
+			wantActive:      aws.Bool(false),
+			wantDescription: aws.String("description"),
+			wantGuidance:    aws.String(servicecatalog.ProvisioningArtifactGuidanceDefault),
+			wantName:        aws.String("name"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{
+				"accept_language": AcceptLanguageEnglish,
+				"product_id":      "prod-1234567890abc",
+			}
+			for k, v := range testCase.raw {
+				raw[k] = v
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceProvisioningArtifact().Schema, raw)
+
+			if testCase.isNewResource {
+				d.MarkNewResource()
+			}
+
+			input := expandUpdateProvisioningArtifactInput(d, "pa-1234567890abc", "prod-1234567890abc")
+
+			if got, want := aws.StringValue(input.AcceptLanguage), AcceptLanguageEnglish; got != want {
+				t.Errorf("AcceptLanguage = %q, want %q", got, want)
+			}
+
+			if got, want := input.Active, testCase.wantActive; (got == nil) != (want == nil) || aws.BoolValue(got) != aws.BoolValue(want) {
+				t.Errorf("Active = %v, want %v", aws.BoolValue(got), aws.BoolValue(want))
+			}
+
+			for _, v := range []struct {
+				field     string
+				got, want *string
+			}{
+				{"Description", input.Description, testCase.wantDescription},
+				{"Guidance", input.Guidance, testCase.wantGuidance},
+				{"Name", input.Name, testCase.wantName},
+			} {
+				if (v.got == nil) != (v.want == nil) || aws.StringValue(v.got) != aws.StringValue(v.want) {
+					t.Errorf("%s = %v, want %v", v.field, aws.StringValue(v.got), aws.StringValue(v.want))
+				}
+			}
+		})
+	}
+}
+
+func TestProvisioningArtifactUpdateAffectsStatus(t *testing.T) {
+	t.Parallel()
+
+	state := map[string]string{
+		"accept_language":             AcceptLanguageEnglish,
+		"active":                      "true",
+		"description":                 "description",
+		"disable_template_validation": "false",
+		"guidance":                    servicecatalog.ProvisioningArtifactGuidanceDefault,
+		"name":                        "name",
+		"product_id":                  "prod-1234567890abc",
+	}
+
+	testCases := map[string]struct {
+		diff map[string]*terraform.ResourceAttrDiff
+		want bool
+	}{
+		"no changes": {
+			want: false,
+		},
+		"guidance": {
+			diff: map[string]*terraform.ResourceAttrDiff{
+				"guidance": {Old: servicecatalog.ProvisioningArtifactGuidanceDefault, New: servicecatalog.ProvisioningArtifactGuidanceDeprecated},
+			},
+			want: false,
+		},
+		"active and disable_template_validation": {
+			diff: map[string]*terraform.ResourceAttrDiff{
+				"active":                      {Old: "true", New: "false"},
+				"disable_template_validation": {Old: "false", New: "true"},
+			},
+			want: false,
+		},
+		"name": {
+			diff: map[string]*terraform.ResourceAttrDiff{
+				"name": {Old: "name", New: "name2"},
+			},
+			want: true,
+		},
+		"guidance and description": {
+			diff: map[string]*terraform.ResourceAttrDiff{
+				"description": {Old: "description", New: "description2"},
+				"guidance":    {Old: servicecatalog.ProvisioningArtifactGuidanceDefault, New: servicecatalog.ProvisioningArtifactGuidanceDeprecated},
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{}}
+			for k, v := range testCase.diff {
+				diff.Attributes[k] = v
+			}
+
+			d, err := schema.InternalMap(ResourceProvisioningArtifact().Schema).Data(&terraform.InstanceState{
+				ID:         "pa-1234567890abc:prod-1234567890abc",
+				Attributes: state,
+			}, diff)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := provisioningArtifactUpdateAffectsStatus(d); got != testCase.want {
+				t.Errorf("provisioningArtifactUpdateAffectsStatus() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestProvisioningArtifactFailureReason(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Output   *servicecatalog.DescribeProvisioningArtifactOutput
+		Expected string
+	}{
+		{
+			Name: "no info",
+			Output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				ProvisioningArtifactDetail: &servicecatalog.ProvisioningArtifactDetail{
+					Active: aws.Bool(true),
+					Id:     aws.String("pa-1234567890abc"),
+					Name:   aws.String("v1"),
+					Type:   aws.String(servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate),
+				},
+				Status: aws.String(servicecatalog.StatusFailed),
+			},
+			Expected: "provisioning artifact status is FAILED",
+		},
+		{
+			Name: "template url",
+			Output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				Info: map[string]*string{
+					"LoadTemplateFromURL": aws.String("https://s3.amazonaws.com/cf-templates-1234567890abc-us-east-1/template.json"),
+				},
+				ProvisioningArtifactDetail: &servicecatalog.ProvisioningArtifactDetail{
+					Id: aws.String("pa-1234567890abc"),
+				},
+				Status: aws.String(servicecatalog.StatusFailed),
+			},
+			Expected: "provisioning artifact status is FAILED",
+		},
+		{
+			Name: "template physical id",
+			Output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				Info: map[string]*string{
+					"ImportFromPhysicalId": aws.String("arn:aws:cloudformation:us-east-1:123456789012:stack/test/12345678-1234-1234-1234-123456789012"),
+				},
+				ProvisioningArtifactDetail: &servicecatalog.ProvisioningArtifactDetail{
+					Id: aws.String("pa-1234567890abc"),
+				},
+				Status: aws.String(servicecatalog.StatusFailed),
+			},
+			Expected: "provisioning artifact status is FAILED",
+		},
+		{
+			Name: "verbose",
+			Output: &servicecatalog.DescribeProvisioningArtifactOutput{
+				Info: map[string]*string{
+					"LoadTemplateFromURL": aws.String("https://s3.amazonaws.com/cf-templates-1234567890abc-us-east-1/template.json"),
+					"TemplateUrl":         aws.String("https://s3.amazonaws.com/sc-123456789012-us-east-1/template.json?X-Amz-Signature=abc"),
+				},
+				ProvisioningArtifactDetail: &servicecatalog.ProvisioningArtifactDetail{
+					Id: aws.String("pa-1234567890abc"),
+				},
+				Status: aws.String(servicecatalog.StatusFailed),
+			},
+			Expected: "provisioning artifact status is FAILED",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := provisioningArtifactFailureReason(testCase.Output); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTemplateValidationFindings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Output   *cloudformation.ValidateTemplateOutput
+		Expected []string
+	}{
+		{
+			Name:     "no output",
+			Output:   nil,
+			Expected: nil,
+		},
+		{
+			Name: "no findings",
+			Output: &cloudformation.ValidateTemplateOutput{
+				Parameters: []*cloudformation.TemplateParameter{
+					{
+						ParameterKey: aws.String("VpcCidr"),
+						DefaultValue: aws.String("10.1.0.0/16"),
+						NoEcho:       aws.Bool(false),
+					},
+					{
+						ParameterKey: aws.String("Password"),
+						NoEcho:       aws.Bool(true),
+					},
+				},
+			},
+			Expected: nil,
+		},
+		{
+			Name: "capabilities",
+			Output: &cloudformation.ValidateTemplateOutput{
+				Capabilities:       aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam, cloudformation.CapabilityCapabilityNamedIam}),
+				CapabilitiesReason: aws.String("The following resource(s) require capabilities: [AWS::IAM::Role]"),
+			},
+			Expected: []string{
+				"Service Catalog Provisioning Artifact template requires capabilities CAPABILITY_IAM, CAPABILITY_NAMED_IAM, which the launch role must be allowed to use: The following resource(s) require capabilities: [AWS::IAM::Role]",
+			},
+		},
+		{
+			Name: "NoEcho parameter with default",
+			Output: &cloudformation.ValidateTemplateOutput{
+				Parameters: []*cloudformation.TemplateParameter{
+					{
+						ParameterKey: aws.String("Password"),
+						DefaultValue: aws.String("****"),
+						NoEcho:       aws.Bool(true),
+					},
+				},
+			},
+			Expected: []string{
+				"Service Catalog Provisioning Artifact template parameter (Password) is NoEcho but has a default value, which is stored in plain text in the template",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := templateValidationFindings(testCase.Output)
+
+			if got, expected := len(diags), len(testCase.Expected); got != expected {
+				t.Fatalf("got %d diagnostics, expected %d: %v", got, expected, diags)
+			}
+
+			for i, v := range diags {
+				if v.Severity != diag.Warning {
+					t.Errorf("diagnostic %d: got severity %v, expected warning", i, v.Severity)
+				}
+
+				if v.Summary != testCase.Expected[i] {
+					t.Errorf("diagnostic %d: got %q, expected %q", i, v.Summary, testCase.Expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package servicecatalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceProvisioningArtifacts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProvisioningArtifactsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(AcceptLanguage_Values(), false),
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guidance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProvisioningArtifactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+
+	productID := d.Get("product_id").(string)
+	artifacts, err := FindProvisioningArtifactsByProductID(ctx, conn, d.Get("accept_language").(string), productID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Service Catalog Provisioning Artifacts for product (%s): %s", productID, err)
+	}
+
+	d.SetId(productID)
+
+	if err := d.Set("provisioning_artifact_details", flattenProvisioningArtifactDetails(artifacts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting provisioning_artifact_details: %s", err)
+	}
+
+	return diags
+}
+
+func flattenProvisioningArtifactDetail(apiObject *servicecatalog.ProvisioningArtifactDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active":      aws.BoolValue(apiObject.Active),
+		"description": aws.StringValue(apiObject.Description),
+		"guidance":    aws.StringValue(apiObject.Guidance),
+		"id":          aws.StringValue(apiObject.Id),
+		"name":        aws.StringValue(apiObject.Name),
+		"type":        aws.StringValue(apiObject.Type),
+	}
+
+	if apiObject.CreatedTime != nil {
+		tfMap["created_time"] = aws.TimeValue(apiObject.CreatedTime).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenProvisioningArtifactDetails(apiObjects []*servicecatalog.ProvisioningArtifactDetail) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenProvisioningArtifactDetail(apiObject))
+	}
+
+	return tfList
+}
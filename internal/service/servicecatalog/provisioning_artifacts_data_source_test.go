@@ -0,0 +1,50 @@
+package servicecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceCatalogProvisioningArtifactsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_provisioning_artifacts.test"
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningArtifactDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningArtifactsDataSourceConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "product_id", resourceName, "product_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_artifact_details.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "provisioning_artifact_details.*", map[string]string{
+						"active":      "true",
+						"description": rName,
+						"guidance":    servicecatalog.ProvisioningArtifactGuidanceDefault,
+						"name":        fmt.Sprintf("%s-2", rName),
+						"type":        servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvisioningArtifactsDataSourceConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccProvisioningArtifactConfig_basic(rName, domain), `
+data "aws_servicecatalog_provisioning_artifacts" "test" {
+  product_id = aws_servicecatalog_provisioning_artifact.test.product_id
+}
+`)
+}
@@ -0,0 +1,316 @@
+package servicecatalog
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceProvisioningParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProvisioningParametersRead,
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(AcceptLanguage_Values(), false),
+			},
+			"constraint_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_no_echo": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"parameter_constraints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_pattern": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"allowed_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"constraint_description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"max_length": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"max_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"min_length": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"min_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"parameter_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameter_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"path_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"usage_instructions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProvisioningParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
+
+	productID := d.Get("product_id").(string)
+	artifactID := d.Get("provisioning_artifact_id").(string)
+	pathID := d.Get("path_id").(string)
+
+	input := &servicecatalog.DescribeProvisioningParametersInput{
+		AcceptLanguage:         aws.String(d.Get("accept_language").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if pathID != "" {
+		input.PathId = aws.String(pathID)
+	}
+
+	output, err := conn.DescribeProvisioningParametersWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioning Parameters (%s, %s): %s", productID, artifactID, err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "describing Service Catalog Provisioning Parameters (%s, %s): empty response", productID, artifactID)
+	}
+
+	d.SetId(strings.Join([]string{productID, artifactID, pathID}, ":"))
+
+	if err := d.Set("constraint_summaries", flattenConstraintSummaries(output.ConstraintSummaries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting constraint_summaries: %s", err)
+	}
+
+	if err := d.Set("parameters", flattenProvisioningArtifactParameters(output.ProvisioningArtifactParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+
+	if err := d.Set("tag_options", flattenTagOptionSummaries(output.TagOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tag_options: %s", err)
+	}
+
+	if err := d.Set("usage_instructions", flattenUsageInstructions(output.UsageInstructions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting usage_instructions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenProvisioningArtifactParameter(apiObject *servicecatalog.ProvisioningArtifactParameter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"default_value":  aws.StringValue(apiObject.DefaultValue),
+		"description":    aws.StringValue(apiObject.Description),
+		"is_no_echo":     aws.BoolValue(apiObject.IsNoEcho),
+		"parameter_key":  aws.StringValue(apiObject.ParameterKey),
+		"parameter_type": aws.StringValue(apiObject.ParameterType),
+	}
+
+	if apiObject.ParameterConstraints != nil {
+		tfMap["parameter_constraints"] = []interface{}{flattenParameterConstraints(apiObject.ParameterConstraints)}
+	}
+
+	return tfMap
+}
+
+func flattenProvisioningArtifactParameters(apiObjects []*servicecatalog.ProvisioningArtifactParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenProvisioningArtifactParameter(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenParameterConstraints(apiObject *servicecatalog.ParameterConstraints) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"allowed_pattern":        aws.StringValue(apiObject.AllowedPattern),
+		"allowed_values":         aws.StringValueSlice(apiObject.AllowedValues),
+		"constraint_description": aws.StringValue(apiObject.ConstraintDescription),
+		"max_length":             aws.StringValue(apiObject.MaxLength),
+		"max_value":              aws.StringValue(apiObject.MaxValue),
+		"min_length":             aws.StringValue(apiObject.MinLength),
+		"min_value":              aws.StringValue(apiObject.MinValue),
+	}
+}
+
+func flattenTagOptionSummary(apiObject *servicecatalog.TagOptionSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"key":    aws.StringValue(apiObject.Key),
+		"values": aws.StringValueSlice(apiObject.Values),
+	}
+}
+
+func flattenTagOptionSummaries(apiObjects []*servicecatalog.TagOptionSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTagOptionSummary(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenUsageInstruction(apiObject *servicecatalog.UsageInstruction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"type":  aws.StringValue(apiObject.Type),
+		"value": aws.StringValue(apiObject.Value),
+	}
+}
+
+func flattenUsageInstructions(apiObjects []*servicecatalog.UsageInstruction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenUsageInstruction(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,138 @@
+package servicecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+)
+
+func TestAccServiceCatalogProvisioningParametersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_servicecatalog_provisioning_parameters.test"
+	resourceNameArtifact := "aws_servicecatalog_provisioning_artifact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := fmt.Sprintf("http://%s", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, servicecatalog.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningParametersDataSourceConfig_basic(rName, domain, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
+					resource.TestCheckResourceAttrPair(dataSourceName, "product_id", resourceNameArtifact, "product_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "path_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.default_value", "t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.description", "Instance type"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.is_no_echo", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_key", "InstanceType"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_type", "String"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_constraints.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_constraints.0.allowed_values.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_constraints.0.allowed_values.0", "t3.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.parameter_constraints.0.allowed_values.1", "t3.small"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvisioningParametersDataSourceConfig_basic(rName, domain, email string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "%[1]s.json"
+
+  content = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Parameters = {
+      InstanceType = {
+        Type          = "String"
+        Description   = "Instance type"
+        Default       = "t3.micro"
+        AllowedValues = ["t3.micro", "t3.small"]
+      }
+    }
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.1.0.0/16"
+        }
+      }
+    }
+  })
+}
+
+resource "aws_servicecatalog_product" "test" {
+  name          = %[1]q
+  owner         = "ägare"
+  type          = "CLOUD_FORMATION_TEMPLATE"
+  support_email = %[3]q
+  support_url   = %[2]q
+
+  provisioning_artifact_parameters {
+    disable_template_validation = true
+    name                        = %[1]q
+    template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+    type                        = "CLOUD_FORMATION_TEMPLATE"
+  }
+}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-2"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  type                        = "CLOUD_FORMATION_TEMPLATE"
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = %[1]q
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = aws_servicecatalog_principal_portfolio_association.test.portfolio_id # avoid depends_on
+  product_id   = aws_servicecatalog_product.test.id
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = data.aws_iam_session_context.current.issuer_arn
+}
+
+data "aws_servicecatalog_launch_paths" "test" {
+  product_id = aws_servicecatalog_product_portfolio_association.test.product_id # avoid depends_on
+}
+
+data "aws_servicecatalog_provisioning_parameters" "test" {
+  product_id               = aws_servicecatalog_provisioning_artifact.test.product_id
+  provisioning_artifact_id = split(":", aws_servicecatalog_provisioning_artifact.test.id)[0]
+  path_id                  = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+}
+`, rName, domain, email)
+}
@@ -293,6 +293,22 @@ func StatusProvisioningArtifact(ctx context.Context, conn *servicecatalog.Servic
 	}
 }
 
+func StatusProvisioningArtifactUsage(ctx context.Context, conn *servicecatalog.ServiceCatalog, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProvisionedProductsByProvisioningArtifactID(ctx, conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) > 0 {
+			return output, ProvisioningArtifactStatusInUse, nil
+		}
+
+		return output, ProvisioningArtifactStatusNotInUse, nil
+	}
+}
+
 func StatusPrincipalPortfolioAssociation(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, principalARN, portfolioID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindPrincipalPortfolioAssociation(ctx, conn, acceptLanguage, principalARN, portfolioID)
@@ -71,6 +71,9 @@ const (
 	// AWS documentation is wrong, says that status will be "AVAILABLE" but it is actually "CREATED"
 	StatusCreated = "CREATED"
 
+	ProvisioningArtifactStatusInUse    = "IN_USE"
+	ProvisioningArtifactStatusNotInUse = "NOT_IN_USE"
+
 	OrganizationAccessStatusError = "ERROR"
 )
 
@@ -424,7 +427,7 @@ func WaitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Ser
 
 func WaitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.ServiceCatalog, id, productID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{servicecatalog.StatusCreating, servicecatalog.StatusAvailable, StatusCreated, StatusUnavailable},
+		Pending: []string{servicecatalog.StatusCreating, servicecatalog.StatusAvailable, StatusCreated, servicecatalog.StatusFailed, StatusUnavailable},
 		Target:  []string{StatusNotFound},
 		Refresh: StatusProvisioningArtifact(ctx, conn, id, productID),
 		Timeout: timeout,
@@ -439,6 +442,24 @@ func WaitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.S
 	return err
 }
 
+func WaitProvisioningArtifactNotInUse(ctx context.Context, conn *servicecatalog.ServiceCatalog, id string, timeout time.Duration) ([]*servicecatalog.ProvisionedProductAttribute, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ProvisioningArtifactStatusInUse},
+		Target:     []string{ProvisioningArtifactStatusNotInUse},
+		Refresh:    StatusProvisioningArtifactUsage(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: MinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*servicecatalog.ProvisionedProductAttribute); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitPrincipalPortfolioAssociationReady(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, principalARN, portfolioID string, timeout time.Duration) (*servicecatalog.Principal, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{StatusNotFound, StatusUnavailable},
@@ -0,0 +1,172 @@
+package ssm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePatchBaselineEffectivePatches() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataPatchBaselineEffectivePatchesRead,
+		Schema: map[string]*schema.Schema{
+			"baseline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"effective_patches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approval_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"classification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cve_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"deployment_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kb_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"msrc_severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"release_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPatchBaselineEffectivePatchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	baselineID := d.Get("baseline_id").(string)
+	input := &ssm.DescribeEffectivePatchesForPatchBaselineInput{
+		BaselineId: aws.String(baselineID),
+	}
+
+	var results []*ssm.EffectivePatch
+
+	err := conn.DescribeEffectivePatchesForPatchBaselinePagesWithContext(ctx, input, func(page *ssm.DescribeEffectivePatchesForPatchBaselineOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, effectivePatch := range page.EffectivePatches {
+			if effectivePatch == nil {
+				continue
+			}
+
+			results = append(results, effectivePatch)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baseline (%s) effective patches: %s", baselineID, err)
+	}
+
+	d.SetId(baselineID)
+
+	if err := d.Set("effective_patches", flattenEffectivePatches(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting effective_patches: %s", err)
+	}
+
+	return diags
+}
+
+func flattenEffectivePatches(apiObjects []*ssm.EffectivePatch) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Patch; v != nil {
+			tfMap["classification"] = aws.StringValue(v.Classification)
+			tfMap["cve_ids"] = aws.StringValueSlice(v.CVEIds)
+			tfMap["id"] = aws.StringValue(v.Id)
+			tfMap["kb_number"] = aws.StringValue(v.KbNumber)
+			tfMap["msrc_severity"] = aws.StringValue(v.MsrcSeverity)
+			tfMap["name"] = aws.StringValue(v.Name)
+			tfMap["product"] = aws.StringValue(v.Product)
+			tfMap["severity"] = aws.StringValue(v.Severity)
+			tfMap["title"] = aws.StringValue(v.Title)
+			tfMap["version"] = aws.StringValue(v.Version)
+
+			if v.ReleaseDate != nil {
+				tfMap["release_date"] = aws.TimeValue(v.ReleaseDate).Format(time.RFC3339)
+			}
+		}
+
+		if v := apiObject.PatchStatus; v != nil {
+			tfMap["compliance_level"] = aws.StringValue(v.ComplianceLevel)
+			tfMap["deployment_status"] = aws.StringValue(v.DeploymentStatus)
+
+			if v.ApprovalDate != nil {
+				tfMap["approval_date"] = aws.TimeValue(v.ApprovalDate).Format(time.RFC3339)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,59 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMPatchBaselineEffectivePatchesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baseline_effective_patches.test"
+	resourceName := "aws_ssm_patch_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselineEffectivePatchesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_id", resourceName, "id"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "effective_patches.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "effective_patches.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "effective_patches.0.compliance_level", ssm.PatchComplianceLevelCritical),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchBaselineEffectivePatchesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "WINDOWS"
+
+  approval_rule {
+    approve_after_days = 7
+    compliance_level   = "CRITICAL"
+
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["CriticalUpdates", "SecurityUpdates"]
+    }
+  }
+}
+
+data "aws_ssm_patch_baseline_effective_patches" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+}
+`, rName)
+}
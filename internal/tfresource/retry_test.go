@@ -112,6 +112,13 @@ func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:ci.aws-in-f
 			},
 			ExpectError: true,
 		},
+		{
+			Name: "non-retryable AWS error message case",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "testmessage1", nil)
+			},
+			ExpectError: true,
+		},
 		{
 			Name: "retryable AWS error timeout",
 			F: func() (interface{}, error) {
@@ -178,6 +178,7 @@ const (
 	LookoutEquipment             = "lookoutequipment"
 	LookoutMetrics               = "lookoutmetrics"
 	LookoutVision                = "lookoutvision"
+	M2                           = "m2"
 	MQ                           = "mq"
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"